@@ -0,0 +1,17 @@
+provider "random" {
+  defaults {
+    password {
+      length           = 32
+      min_special      = 2
+      override_special = "!#$%&*()-_=+"
+    }
+  }
+}
+
+# Uses the provider defaults for length, min_special and override_special.
+resource "random_password" "database" {}
+
+# Resource configuration takes precedence over the provider defaults.
+resource "random_password" "api_key" {
+  length = 64
+}
@@ -0,0 +1,5 @@
+provider "random" {
+  entropy_source {
+    file = "/var/run/hsm/entropy"
+  }
+}
@@ -0,0 +1,18 @@
+# Only for use in tests. Prefer setting the RANDOM_REPRODUCIBLE_SEED
+# environment variable so that the seed is not committed to configuration.
+provider "random" {
+  reproducible_seed = "acceptance-tests"
+}
+
+# Each resource must set keepers which identify it in reproducible mode.
+resource "random_pet" "primary" {
+  keepers = {
+    name = "primary"
+  }
+}
+
+resource "random_pet" "secondary" {
+  keepers = {
+    name = "secondary"
+  }
+}
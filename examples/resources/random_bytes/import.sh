@@ -0,0 +1,3 @@
+# Random Bytes can be imported by specifying the standard base64 encoding of the bytes,
+# optionally preceded by the purpose and a colon.
+terraform import random_bytes.key "aes256:AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8="
\ No newline at end of file
@@ -0,0 +1,19 @@
+resource "random_bytes" "session_key" {
+  purpose = "fernet"
+}
+
+resource "random_bytes" "jwt_signing_key" {
+  purpose = "hmac-sha256"
+  length  = 64
+}
+
+resource "kubernetes_secret" "app" {
+  metadata {
+    name = "app-keys"
+  }
+
+  data = {
+    SESSION_KEY     = random_bytes.session_key.base64url
+    JWT_SIGNING_KEY = random_bytes.jwt_signing_key.hex
+  }
+}
@@ -6,4 +6,13 @@
 terraform import random_id.server p-9hUg
 
 # Example with prefix (prefix is separated by a ,):
-$ terraform import random_id.server my-prefix-,p-9hUg
\ No newline at end of file
+$ terraform import random_id.server my-prefix-,p-9hUg
+
+# The hex, dec or b64_std can be imported instead by preceding the import ID
+# with an encoding hint of hex, dec or b64std, followed by a :
+terraform import random_id.server hex:my-prefix-,a7ef6152
+
+# Leading zero bytes are lost when an ID is encoded in decimal, so the
+# byte_length, from 1 to 1048576, can be given after the dec hint, followed
+# by a :
+terraform import random_id.server dec:8:my-prefix-,2817483090
@@ -1,6 +1,15 @@
 # Random integers can be imported using the result, min, and max, with an
 # optional seed. This can be used to replace a config value with a value
 # interpolated from the random provider without experiencing diffs.
+#
+# The result must be between min and max. When a seed is given, the
+# seed_algorithm with which the seed reproduces the result is used, and a
+# warning is shown if there is none.
 
 # Example (values are separated by a ,):
-terraform import random_integer.priority 15390,1,50000
\ No newline at end of file
+terraform import random_integer.priority 15390,1,50000
+
+# Like random_shuffle, the import ID can instead be a JSON object of the
+# result, min and max, with an optional seed, seed_algorithm and keepers.
+# When a seed_algorithm is given, only it is checked against the seed.
+terraform import random_integer.priority '{"result": 15390, "min": 1, "max": 50000, "seed": "prod", "seed_algorithm": "v2"}'
@@ -0,0 +1,9 @@
+# Random Passphrase can be imported by specifying the value of the passphrase, with its
+# words separated by a single space.
+terraform import random_passphrase.passphrase "correct pony battery staple"
+
+# When the words are separated by another separator, the passphrase can be given as the
+# result of a JSON object with its words, separator, capitalize, include_number and keepers.
+# Attributes which are omitted are inferred from the passphrase, and the import fails if
+# the passphrase could not have been generated with them.
+terraform import random_passphrase.passphrase '{"result": "Correct-Pony-T-shirt-Staple", "words": 4, "separator": "-"}'
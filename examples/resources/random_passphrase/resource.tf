@@ -0,0 +1,13 @@
+resource "random_passphrase" "break_glass" {
+  words          = 6
+  capitalize     = true
+  include_number = true
+}
+
+resource "vault_generic_secret" "break_glass" {
+  path = "secret/break-glass/admin"
+
+  data_json = jsonencode({
+    password = random_passphrase.break_glass.result
+  })
+}
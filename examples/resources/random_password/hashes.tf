@@ -0,0 +1,18 @@
+resource "random_password" "user" {
+  length = 24
+
+  hash_options {
+    algorithms          = ["sha512_crypt"]
+    sha512_crypt_rounds = 656000
+  }
+}
+
+# The SHA-512 crypt hash may be used to set the password of a Linux user with cloud-init.
+locals {
+  cloud_config = yamlencode({
+    users = [{
+      name   = "deploy"
+      passwd = random_password.user.hashes.sha512_crypt
+    }]
+  })
+}
@@ -0,0 +1,15 @@
+resource "random_password" "ingress" {
+  length            = 24
+  htpasswd_username = "admin"
+}
+
+# The htpasswd line may be used for HTTP basic authentication, such as by the NGINX ingress controller.
+resource "kubernetes_secret" "basic_auth" {
+  metadata {
+    name = "basic-auth"
+  }
+
+  data = {
+    auth = random_password.ingress.htpasswd_line
+  }
+}
@@ -1,2 +1,5 @@
 # Random Password can be imported by specifying the value of the password. 
-terraform import random_password.password securepassword
\ No newline at end of file
+terraform import random_password.password securepassword
+
+# The attributes with which the password was generated can also be imported, as a JSON object.
+terraform import random_password.password '{"result": "Xk4pQ7", "special": false, "min_numeric": 2}'
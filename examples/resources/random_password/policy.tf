@@ -0,0 +1,10 @@
+resource "random_password" "postgresql" {
+  policy = "postgresql"
+}
+
+# The policy may be tightened, but not violated.
+resource "random_password" "oracle" {
+  policy           = "oracle"
+  length           = 20
+  override_special = "_"
+}
@@ -0,0 +1,19 @@
+# Both the current and previous passwords are accepted, so that clients
+# using the previous password continue to work after it is replaced by
+# the first plan after 30 days.
+resource "random_password" "api_key" {
+  length          = 32
+  special         = false
+  rotation_period = "30d"
+}
+
+resource "kubernetes_secret" "api_keys" {
+  metadata {
+    name = "api-keys"
+  }
+
+  data = {
+    current  = random_password.api_key.result
+    previous = coalesce(random_password.api_key.previous_result, random_password.api_key.result)
+  }
+}
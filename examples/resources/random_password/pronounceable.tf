@@ -0,0 +1,6 @@
+# A support PIN which is easy to read over the phone, such as "tavemo42".
+resource "random_password" "support_pin" {
+  length      = 8
+  mode        = "pronounceable"
+  min_numeric = 2
+}
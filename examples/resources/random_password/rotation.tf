@@ -0,0 +1,5 @@
+# The password is replaced by the first plan after 90 days.
+resource "random_password" "service" {
+  length          = 24
+  rotation_period = "90d"
+}
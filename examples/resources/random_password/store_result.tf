@@ -0,0 +1,17 @@
+resource "random_password" "replication" {
+  length       = 32
+  store_result = false
+
+  hash_options {
+    algorithms = ["postgresql_scram_sha256"]
+  }
+}
+
+# The result is never written to state, so only its hashes may be referenced. The
+# SCRAM-SHA-256 verifier may be used as the password of a PostgreSQL role.
+resource "postgresql_role" "replication" {
+  name        = "replication"
+  login       = true
+  replication = true
+  password    = random_password.replication.hashes.postgresql_scram_sha256
+}
@@ -0,0 +1,9 @@
+# Random pet names can be imported using the name. The separator is the last
+# run of characters other than letters and digits, and the prefix is anything
+# preceding the longest run of words at the end of the name which random_pet
+# could have generated: any adverbs, then an adjective, then an animal name.
+terraform import random_pet.server prod01-charming-dingo
+
+# When the prefix or separator cannot be inferred, they can be given with the
+# name, as id, in a JSON object with optional keepers.
+terraform import random_pet.server '{"id": "web-server-charming-dingo", "prefix": "web-server"}'
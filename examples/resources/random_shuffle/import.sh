@@ -0,0 +1,10 @@
+# Random shuffles can be imported using a JSON object of the input and result,
+# with an optional result_count, seed, seed_algorithm and keepers. The result
+# must be a valid shuffle of the input, taking no element more often than it
+# appears in the input in each len(input) elements of the result.
+#
+# When a seed is given without a seed_algorithm, the algorithm with which the
+# seed produces the result is used. A warning is shown if there is none.
+
+# Example (result_count is inferred from the length of the result):
+terraform import random_shuffle.az '{"input": ["us-west-1a", "us-west-1c", "us-west-1d", "us-west-1e"], "result": ["us-west-1d", "us-west-1a"]}'
@@ -1,2 +1,5 @@
 # Random String can be imported by specifying the value of the string.
-terraform import random_string.test test
\ No newline at end of file
+terraform import random_string.test test
+
+# The attributes with which the string was generated can also be imported, as a JSON object.
+terraform import random_string.test '{"result": "Xk4pQ7", "special": false, "min_numeric": 2}'
@@ -0,0 +1,7 @@
+# The string is replaced by the first plan after 30 days, or after the
+# end of 2030 if that is earlier.
+resource "random_string" "token" {
+  length          = 32
+  rotation_period = "30d"
+  rotate_after    = "2030-12-31T23:59:59Z"
+}
@@ -0,0 +1,88 @@
+package passwordhash
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"encoding/base64"
+)
+
+const (
+	// APR1SaltLength is the maximum length of the salt of APR1 hashes.
+	APR1SaltLength = 8
+
+	apr1Rounds = 1000
+)
+
+// apr1Order is the order in which bytes of the final APR1 digest are encoded.
+var apr1Order = []int{0, 6, 12, 1, 7, 13, 2, 8, 14, 3, 9, 15, 4, 10, 5, 11}
+
+// APR1 returns the Apache variant of the MD5 crypt hash of password, as generated by
+// htpasswd -m: $apr1$<salt>$<hash>. The salt, such as one returned by CryptSalt, is
+// truncated to 8 characters.
+func APR1(password, salt string) string {
+	const magic = "$apr1$"
+
+	if len(salt) > APR1SaltLength {
+		salt = salt[:APR1SaltLength]
+	}
+
+	pw := []byte(password)
+
+	alternate := md5.Sum([]byte(password + salt + password))
+
+	h := md5.New()
+	h.Write(pw)
+	h.Write([]byte(magic + salt))
+	h.Write(repeat(alternate[:], len(pw)))
+
+	for i := len(pw); i > 0; i >>= 1 {
+		if i&1 != 0 {
+			h.Write([]byte{0})
+		} else {
+			h.Write(pw[:1])
+		}
+	}
+
+	c := h.Sum(nil)
+
+	for i := 0; i < apr1Rounds; i++ {
+		h.Reset()
+
+		if i&1 != 0 {
+			h.Write(pw)
+		} else {
+			h.Write(c)
+		}
+
+		if i%3 != 0 {
+			h.Write([]byte(salt))
+		}
+
+		if i%7 != 0 {
+			h.Write(pw)
+		}
+
+		if i&1 != 0 {
+			h.Write(c)
+		} else {
+			h.Write(pw)
+		}
+
+		c = h.Sum(nil)
+	}
+
+	permuted := make([]byte, len(c))
+	for i, j := range apr1Order {
+		permuted[i] = c[j]
+	}
+
+	return magic + salt + "$" + cryptEncode(permuted)
+}
+
+// HtpasswdSHA1 returns the unsalted SHA1 hash of password, as generated by htpasswd -s:
+// {SHA} followed by the standard base64 SHA1(password).
+func HtpasswdSHA1(password string) string {
+	sum := sha1.Sum([]byte(password))
+
+	return "{SHA}" + base64.StdEncoding.EncodeToString(sum[:])
+}
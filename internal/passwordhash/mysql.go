@@ -0,0 +1,40 @@
+package passwordhash
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+const (
+	// MySQLCachingSHA2SaltLength is the length of the salt of caching_sha2_password hashes.
+	MySQLCachingSHA2SaltLength = 20
+
+	// mysqlCachingSHA2Rounds is the default number of rounds of caching_sha2_password.
+	mysqlCachingSHA2Rounds = 5000
+)
+
+// MySQLNativePassword returns the mysql_native_password hash of password, which is accepted
+// by CREATE USER ... IDENTIFIED WITH mysql_native_password AS: * followed by the upper case
+// hexadecimal SHA1(SHA1(password)).
+func MySQLNativePassword(password string) string {
+	first := sha1.Sum([]byte(password))
+	second := sha1.Sum(first[:])
+
+	return "*" + strings.ToUpper(hex.EncodeToString(second[:]))
+}
+
+// MySQLCachingSHA2Password returns the caching_sha2_password hash of password, which is
+// accepted by CREATE USER ... IDENTIFIED WITH caching_sha2_password AS:
+// $A$<rounds / 1000>$<salt><hash>, where the hash is a SHA-256 crypt digest of 5000 rounds.
+// The salt, such as one returned by CryptSalt, must be 20 characters.
+func MySQLCachingSHA2Password(password, salt string) (string, error) {
+	if len(salt) != MySQLCachingSHA2SaltLength {
+		return "", fmt.Errorf("salt must be %d characters, got: %d", MySQLCachingSHA2SaltLength, len(salt))
+	}
+
+	digest := shaCryptDigest(sha256Crypt, []byte(password), []byte(salt), mysqlCachingSHA2Rounds)
+
+	return fmt.Sprintf("$A$%03X$%s%s", mysqlCachingSHA2Rounds/1000, salt, shaCryptEncode(sha256Crypt, digest)), nil
+}
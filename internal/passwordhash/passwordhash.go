@@ -0,0 +1,135 @@
+// Package passwordhash generates password verifiers in the formats expected by
+// operating systems, web frameworks and identity providers.
+package passwordhash
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// SaltLength is the length, in bytes, of salts generated by Salt.
+const SaltLength = 16
+
+// b64 is the encoding used by the PHC string format: standard base64 without
+// padding.
+var b64 = base64.RawStdEncoding
+
+// Salt reads a random salt of SaltLength bytes from r.
+func Salt(r io.Reader) ([]byte, error) {
+	salt := make([]byte, SaltLength)
+
+	if _, err := io.ReadFull(r, salt); err != nil {
+		return nil, err
+	}
+
+	return salt, nil
+}
+
+// Argon2idParams are the cost parameters of Argon2id.
+type Argon2idParams struct {
+	// Memory is the amount of memory used, in KiB.
+	Memory uint32
+
+	Iterations  uint32
+	Parallelism uint8
+}
+
+// Argon2id returns the Argon2id hash of password in the PHC string format:
+// $argon2id$v=19$m=<memory>,t=<iterations>,p=<parallelism>$<salt>$<hash>.
+func Argon2id(password string, salt []byte, params Argon2idParams) string {
+	key := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, 32)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, params.Memory, params.Iterations, params.Parallelism, b64.EncodeToString(salt), b64.EncodeToString(key))
+}
+
+// ScryptParams are the cost parameters of scrypt.
+type ScryptParams struct {
+	// Cost is the base 2 logarithm of the CPU/memory cost parameter, N.
+	Cost int
+
+	BlockSize   int
+	Parallelism int
+}
+
+// Scrypt returns the scrypt hash of password in the PHC string format:
+// $scrypt$ln=<cost>,r=<block size>,p=<parallelism>$<salt>$<hash>.
+func Scrypt(password string, salt []byte, params ScryptParams) (string, error) {
+	key, err := scrypt.Key([]byte(password), salt, 1<<params.Cost, params.BlockSize, params.Parallelism, 32)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("$scrypt$ln=%d,r=%d,p=%d$%s$%s",
+		params.Cost, params.BlockSize, params.Parallelism, b64.EncodeToString(salt), b64.EncodeToString(key)), nil
+}
+
+// PBKDF2SHA256 returns the PBKDF2-HMAC-SHA256 hash of password in the PHC
+// string format: $pbkdf2-sha256$i=<iterations>$<salt>$<hash>.
+func PBKDF2SHA256(password string, salt []byte, iterations int) string {
+	key := pbkdf2.Key([]byte(password), salt, iterations, sha256.Size, sha256.New)
+
+	return fmt.Sprintf("$pbkdf2-sha256$i=%d$%s$%s", iterations, b64.EncodeToString(salt), b64.EncodeToString(key))
+}
+
+// SHA512Crypt returns the SHA-512 crypt hash of password, as used in
+// /etc/shadow: $6$rounds=<rounds>$<salt>$<hash>. The rounds are omitted when
+// they are the default of 5000.
+//
+// The salt, such as one returned by CryptSalt, is truncated to 16 characters,
+// and rounds are clamped to between 1000 and 999999999, as specified by
+// https://www.akkadia.org/drepper/SHA-crypt.txt.
+func SHA512Crypt(password, salt string, rounds int) string {
+	return shaCrypt(sha512Crypt, password, salt, rounds)
+}
+
+// CryptSalt returns the first n characters of salt encoded with the crypt
+// alphabet.
+func CryptSalt(salt []byte, n int) string {
+	s := cryptEncode(salt)
+
+	if len(s) > n {
+		s = s[:n]
+	}
+
+	return s
+}
+
+// cryptAlphabet is the alphabet of the base64 variant used by crypt(3).
+const cryptAlphabet = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// cryptEncode encodes b with the crypt alphabet. Each group of three bytes is
+// read as a big-endian integer, which is encoded least significant bits first.
+func cryptEncode(b []byte) string {
+	var s strings.Builder
+
+	for i := 0; i < len(b); i += 3 {
+		var w uint32
+		n := 4
+
+		switch len(b) - i {
+		case 1:
+			w = uint32(b[i])
+			n = 2
+		case 2:
+			w = uint32(b[i])<<8 | uint32(b[i+1])
+			n = 3
+		default:
+			w = uint32(b[i])<<16 | uint32(b[i+1])<<8 | uint32(b[i+2])
+		}
+
+		for ; n > 0; n-- {
+			s.WriteByte(cryptAlphabet[w&0x3f])
+			w >>= 6
+		}
+	}
+
+	return s.String()
+}
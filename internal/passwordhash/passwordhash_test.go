@@ -0,0 +1,273 @@
+package passwordhash
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// The test vectors of https://www.akkadia.org/drepper/SHA-crypt.txt. The default rounds
+// are omitted from the result even when they were given explicitly in the specification.
+func TestSHA512Crypt(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		password string
+		salt     string
+		rounds   int
+		expected string
+	}{
+		{
+			password: "Hello world!",
+			salt:     "saltstring",
+			rounds:   5000,
+			expected: "$6$saltstring$svn8UoSVapNtMuq1ukKS4tPQd8iKwSMHWjl/O817G3uBnIFNjnQJuesI68u4OTLiBFdcbYEdFCoEOfaS35inz1",
+		},
+		{
+			password: "Hello world!",
+			salt:     "saltstringsaltstring",
+			rounds:   10000,
+			expected: "$6$rounds=10000$saltstringsaltst$OW1/O6BYHV6BcXZu8QVeXbDWra3Oeqh0sbHbbMCVNSnCM/UrjmM0Dp8vOuZeHBy/YTBmSK6H9qs/y3RnOaw5v.",
+		},
+		{
+			password: "This is just a test",
+			salt:     "toolongsaltstring",
+			rounds:   5000,
+			expected: "$6$toolongsaltstrin$lQ8jolhgVRVhY4b5pZKaysCLi0QBxGoNeKQzQ3glMhwllF7oGDZxUhx1yxdYcz/e1JSbq3y6JMxxl8audkUEm0",
+		},
+		{
+			password: "we have a short salt string but not a short password",
+			salt:     "short",
+			rounds:   77777,
+			expected: "$6$rounds=77777$short$WuQyW2YR.hBNpjjRhpYD/ifIw05xdfeEyQoMxIXbkvr0gge1a1x3yRULJ5CCaUeOxFmtlcGZelFl5CxtgfiAc0",
+		},
+		{
+			password: "the minimum number is still observed",
+			salt:     "roundstoolow",
+			rounds:   10,
+			expected: "$6$rounds=1000$roundstoolow$kUMsbe306n21p9R.FRkW3IGn.S9NPN0x50YhH1xhLsPuWGsUSklZt58jaTfF4ZEQpyUNGc0dqbpBYYBaHHrsX.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		if got := SHA512Crypt(testCase.password, testCase.salt, testCase.rounds); got != testCase.expected {
+			t.Errorf("expected %s, got: %s", testCase.expected, got)
+		}
+	}
+}
+
+func TestArgon2id(t *testing.T) {
+	t.Parallel()
+
+	salt := []byte("0123456789abcdef")
+	params := Argon2idParams{Memory: 64, Iterations: 1, Parallelism: 2}
+
+	got := Argon2id("password", salt, params)
+
+	if !regexp.MustCompile(`^\$argon2id\$v=19\$m=64,t=1,p=2\$MDEyMzQ1Njc4OWFiY2RlZg\$[A-Za-z0-9+/]{43}$`).MatchString(got) {
+		t.Fatalf("unexpected format: %s", got)
+	}
+
+	key, err := b64.DecodeString(got[len(got)-43:])
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := argon2.IDKey([]byte("password"), salt, 1, 64, 2, 32); !bytes.Equal(key, expected) {
+		t.Errorf("expected key %x, got: %x", expected, key)
+	}
+}
+
+func TestScrypt(t *testing.T) {
+	t.Parallel()
+
+	got, err := Scrypt("password", []byte("0123456789abcdef"), ScryptParams{Cost: 4, BlockSize: 8, Parallelism: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !regexp.MustCompile(`^\$scrypt\$ln=4,r=8,p=1\$MDEyMzQ1Njc4OWFiY2RlZg\$[A-Za-z0-9+/]{43}$`).MatchString(got) {
+		t.Errorf("unexpected format: %s", got)
+	}
+
+	if _, err := Scrypt("password", []byte("salt"), ScryptParams{Cost: 0, BlockSize: 8, Parallelism: 1}); err == nil {
+		t.Error("expected error when N is 1")
+	}
+}
+
+// The PBKDF2-HMAC-SHA256 test vector of RFC 7914, truncated to 32 bytes.
+func TestPBKDF2SHA256(t *testing.T) {
+	t.Parallel()
+
+	got := PBKDF2SHA256("passwd", []byte("salt"), 1)
+	expected := "$pbkdf2-sha256$i=1$c2FsdA$VawEblbjCJ/sFpHCJUS2BflBhSFt3gRl5oudV8INrLw"
+
+	if got != expected {
+		t.Errorf("expected %s, got: %s", expected, got)
+	}
+}
+
+func TestCryptSalt(t *testing.T) {
+	t.Parallel()
+
+	salt := CryptSalt([]byte{0xff, 0xff, 0xff, 0x00, 0x00, 0x00, 0x01}, 16)
+
+	if salt != "zzzz..../." {
+		t.Errorf("unexpected salt: %s", salt)
+	}
+
+	if salt := CryptSalt(bytes.Repeat([]byte{0}, SaltLength), 12); salt != "............" {
+		t.Errorf("unexpected salt: %s", salt)
+	}
+}
+
+// The test vectors of https://www.akkadia.org/drepper/SHA-crypt.txt.
+func TestSHA256Crypt(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		password string
+		salt     string
+		rounds   int
+		expected string
+	}{
+		{
+			password: "Hello world!",
+			salt:     "saltstring",
+			rounds:   5000,
+			expected: "$5$saltstring$5B8vYYiY.CVt1RlTTf8KbXBH3hsxY/GNooZaBBGWEc5",
+		},
+		{
+			password: "Hello world!",
+			salt:     "saltstringsaltstring",
+			rounds:   10000,
+			expected: "$5$rounds=10000$saltstringsaltst$3xv.VbSHBb41AL9AvLeujZkZRBAwqFMz2.opqey6IcA",
+		},
+	}
+
+	for _, testCase := range testCases {
+		if got := shaCrypt(sha256Crypt, testCase.password, testCase.salt, testCase.rounds); got != testCase.expected {
+			t.Errorf("expected %s, got: %s", testCase.expected, got)
+		}
+	}
+}
+
+func TestPostgreSQLSCRAMSHA256(t *testing.T) {
+	t.Parallel()
+
+	expected := "SCRAM-SHA-256$4096:MDEyMzQ1Njc4OWFiY2RlZg==$nQpbZ77WudtqufPwikHXGRt6g2QJ4zns8bZLw273DRM=:" +
+		"jn2amWP1q1h+jgjy0YTO14S6/F02SV7taipOeB7ef20="
+
+	if got := PostgreSQLSCRAMSHA256("pencil", []byte("0123456789abcdef"), 4096); got != expected {
+		t.Errorf("expected %s, got: %s", expected, got)
+	}
+
+	// Full width characters are normalized by SASLprep.
+	if got := PostgreSQLSCRAMSHA256("\uff50\uff45\uff4e\uff43\uff49\uff4c", []byte("0123456789abcdef"), 4096); got != expected {
+		t.Errorf("expected %s, got: %s", expected, got)
+	}
+}
+
+func TestSASLPrep(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"user":         "user",
+		"a\u0007b":     "a\u0007b",
+		"I\u00adX":     "IX",
+		"\u00aa":       "a",
+		"\u2168":       "IX",
+		"\uff41":       "a",
+		"a\u00a0b":     "a b",
+		"\u20ac\u0007": "\u20ac\u0007",
+	}
+
+	for input, expected := range testCases {
+		if got := saslPrep(input); got != expected {
+			t.Errorf("%q: expected %q, got: %q", input, expected, got)
+		}
+	}
+}
+
+func TestMySQLNativePassword(t *testing.T) {
+	t.Parallel()
+
+	expected := "*2470C0C06DEE42FD1618BB99005ADCA2EC9D1E19"
+
+	if got := MySQLNativePassword("password"); got != expected {
+		t.Errorf("expected %s, got: %s", expected, got)
+	}
+}
+
+func TestMySQLCachingSHA2Password(t *testing.T) {
+	t.Parallel()
+
+	salt := "abcdefghijklmnopqrst"
+
+	got, err := MySQLCachingSHA2Password("password", salt)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !regexp.MustCompile(`^\$A\$005\$abcdefghijklmnopqrst[./0-9A-Za-z]{43}$`).MatchString(got) {
+		t.Errorf("unexpected format: %s", got)
+	}
+
+	// The digest is that of SHA-256 crypt, without truncating the salt to 16 characters.
+	if digest := shaCryptEncode(sha256Crypt, shaCryptDigest(sha256Crypt, []byte("password"), []byte(salt), 5000)); got[27:] != digest {
+		t.Errorf("expected digest %s, got: %s", digest, got[27:])
+	}
+
+	if _, err := MySQLCachingSHA2Password("password", "short"); err == nil {
+		t.Error("expected error when the salt is not 20 characters")
+	}
+}
+
+func TestAPR1(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		password string
+		salt     string
+		expected string
+	}{
+		{
+			password: "password",
+			salt:     "saltsalt",
+			expected: "$apr1$saltsalt$yAAkm4libquA.ZWLHbSBq/",
+		},
+		{
+			password: "password",
+			salt:     "saltsaltsalt",
+			expected: "$apr1$saltsalt$yAAkm4libquA.ZWLHbSBq/",
+		},
+		{
+			password: "",
+			salt:     "ab",
+			expected: "$apr1$ab$S8K6Sgp3W8c9Jb6LxgywZ.",
+		},
+		{
+			password: "a very long password that exceeds sixteen bytes",
+			salt:     "12345678",
+			expected: "$apr1$12345678$uLJCzDmVKltBxOrieVvHN1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		if got := APR1(testCase.password, testCase.salt); got != testCase.expected {
+			t.Errorf("expected %s, got: %s", testCase.expected, got)
+		}
+	}
+}
+
+func TestHtpasswdSHA1(t *testing.T) {
+	t.Parallel()
+
+	expected := "{SHA}W6ph5Mm5Pz8GgiULbPgzG37mj9g="
+
+	if got := HtpasswdSHA1("password"); got != expected {
+		t.Errorf("expected %s, got: %s", expected, got)
+	}
+}
@@ -0,0 +1,108 @@
+package passwordhash
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+// PostgreSQLSCRAMSHA256 returns the SCRAM-SHA-256 verifier of password in the format stored
+// by PostgreSQL, which is accepted in place of the password by CREATE ROLE and ALTER ROLE:
+// SCRAM-SHA-256$<iterations>:<salt>$<StoredKey>:<ServerKey>.
+func PostgreSQLSCRAMSHA256(password string, salt []byte, iterations int) string {
+	saltedPassword := pbkdf2.Key([]byte(saslPrep(password)), salt, iterations, sha256.Size, sha256.New)
+
+	clientKey := hmacSHA256(saltedPassword, "Client Key")
+	storedKey := sha256.Sum256(clientKey)
+	serverKey := hmacSHA256(saltedPassword, "Server Key")
+
+	return fmt.Sprintf("SCRAM-SHA-256$%d:%s$%s:%s", iterations, base64.StdEncoding.EncodeToString(salt),
+		base64.StdEncoding.EncodeToString(storedKey[:]), base64.StdEncoding.EncodeToString(serverKey))
+}
+
+func hmacSHA256(key []byte, message string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(message))
+
+	return mac.Sum(nil)
+}
+
+// saslPrep prepares password with SASLprep (RFC 4013), as PostgreSQL does before hashing it.
+// As in PostgreSQL, password is used unchanged if it is ASCII, or if it contains prohibited
+// characters once prepared. The checks for unassigned code points and bidirectional
+// characters are omitted, as they rarely apply to generated passwords.
+func saslPrep(password string) string {
+	ascii := true
+
+	for i := 0; i < len(password); i++ {
+		if password[i] >= utf8.RuneSelf {
+			ascii = false
+			break
+		}
+	}
+
+	if ascii {
+		return password
+	}
+
+	mapped := strings.Map(func(r rune) rune {
+		switch {
+		case saslMappedToNothing(r):
+			return -1
+		case r != ' ' && unicode.Is(unicode.Zs, r):
+			return ' '
+		default:
+			return r
+		}
+	}, password)
+
+	prepared := norm.NFKC.String(mapped)
+
+	for _, r := range prepared {
+		if saslProhibited(r) {
+			return password
+		}
+	}
+
+	return prepared
+}
+
+// saslMappedToNothing returns whether r is in table B.1 of RFC 3454.
+func saslMappedToNothing(r rune) bool {
+	switch {
+	case r == 0x00AD, r == 0x034F, r == 0x1806, r == 0x2060, r == 0xFEFF:
+		return true
+	case r >= 0x180B && r <= 0x180D, r >= 0x200B && r <= 0x200D, r >= 0xFE00 && r <= 0xFE0F:
+		return true
+	}
+
+	return false
+}
+
+// saslProhibited returns whether r is prohibited by SASLprep, other than non-ASCII space
+// characters, which are mapped to a space beforehand.
+func saslProhibited(r rune) bool {
+	switch {
+	case unicode.Is(unicode.Cc, r), unicode.Is(unicode.Co, r), unicode.Is(unicode.Cs, r):
+		return true
+	case r >= 0xFDD0 && r <= 0xFDEF, r&0xFFFE == 0xFFFE:
+		return true
+	case r >= 0xFFF9 && r <= 0xFFFD, r >= 0x2FF0 && r <= 0x2FFB:
+		return true
+	case r == 0x0340, r == 0x0341, r == 0x200E, r == 0x200F, r >= 0x202A && r <= 0x202E, r >= 0x206A && r <= 0x206F:
+		return true
+	case r == 0xE0001, r >= 0xE0020 && r <= 0xE007F:
+		return true
+	case r == utf8.RuneError:
+		return true
+	}
+
+	return false
+}
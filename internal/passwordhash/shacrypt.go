@@ -0,0 +1,161 @@
+package passwordhash
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"hash"
+)
+
+const (
+	shaCryptDefaultRounds = 5000
+	shaCryptMinRounds     = 1000
+	shaCryptMaxRounds     = 999999999
+	shaCryptMaxSalt       = 16
+)
+
+// shaCryptVariant is a digest of the SHA-crypt family specified by
+// https://www.akkadia.org/drepper/SHA-crypt.txt.
+type shaCryptVariant struct {
+	// id is the prefix identifying the variant, between the first two $.
+	id string
+
+	hash func() hash.Hash
+
+	// order is the order in which bytes of the final digest are encoded.
+	order []int
+}
+
+var sha256Crypt = shaCryptVariant{
+	id:   "5",
+	hash: sha256.New,
+	order: []int{
+		0, 10, 20, 21, 1, 11, 12, 22, 2, 3, 13, 23, 24, 4, 14, 15, 25, 5, 6, 16, 26, 27, 7, 17,
+		18, 28, 8, 9, 19, 29, 31, 30,
+	},
+}
+
+var sha512Crypt = shaCryptVariant{
+	id:   "6",
+	hash: sha512.New,
+	order: []int{
+		0, 21, 42, 22, 43, 1, 44, 2, 23, 3, 24, 45, 25, 46, 4, 47, 5, 26, 6, 27, 48, 28, 49, 7,
+		50, 8, 29, 9, 30, 51, 31, 52, 10, 53, 11, 32, 12, 33, 54, 34, 55, 13, 56, 14, 35, 15, 36, 57,
+		37, 58, 16, 59, 17, 38, 18, 39, 60, 40, 61, 19, 62, 20, 41, 63,
+	},
+}
+
+func shaCrypt(v shaCryptVariant, password, salt string, rounds int) string {
+	if len(salt) > shaCryptMaxSalt {
+		salt = salt[:shaCryptMaxSalt]
+	}
+
+	if rounds < shaCryptMinRounds {
+		rounds = shaCryptMinRounds
+	}
+
+	if rounds > shaCryptMaxRounds {
+		rounds = shaCryptMaxRounds
+	}
+
+	digest := shaCryptEncode(v, shaCryptDigest(v, []byte(password), []byte(salt), rounds))
+
+	if rounds == shaCryptDefaultRounds {
+		return fmt.Sprintf("$%s$%s$%s", v.id, salt, digest)
+	}
+
+	return fmt.Sprintf("$%s$rounds=%d$%s$%s", v.id, rounds, salt, digest)
+}
+
+// shaCryptEncode encodes the final digest of the variant with the crypt alphabet.
+func shaCryptEncode(v shaCryptVariant, digest []byte) string {
+	permuted := make([]byte, len(digest))
+	for i, j := range v.order {
+		permuted[i] = digest[j]
+	}
+
+	return cryptEncode(permuted)
+}
+
+func shaCryptDigest(v shaCryptVariant, password, salt []byte, rounds int) []byte {
+	h := v.hash()
+
+	h.Write(password)
+	h.Write(salt)
+	h.Write(password)
+	b := h.Sum(nil)
+
+	h.Reset()
+	h.Write(password)
+	h.Write(salt)
+	h.Write(repeat(b, len(password)))
+
+	for i := len(password); i > 0; i >>= 1 {
+		if i&1 != 0 {
+			h.Write(b)
+		} else {
+			h.Write(password)
+		}
+	}
+
+	a := h.Sum(nil)
+
+	h.Reset()
+	for range password {
+		h.Write(password)
+	}
+
+	p := repeat(h.Sum(nil), len(password))
+
+	h.Reset()
+	for i := 0; i < 16+int(a[0]); i++ {
+		h.Write(salt)
+	}
+
+	s := repeat(h.Sum(nil), len(salt))
+
+	c := a
+
+	for i := 0; i < rounds; i++ {
+		h.Reset()
+
+		if i&1 != 0 {
+			h.Write(p)
+		} else {
+			h.Write(c)
+		}
+
+		if i%3 != 0 {
+			h.Write(s)
+		}
+
+		if i%7 != 0 {
+			h.Write(p)
+		}
+
+		if i&1 != 0 {
+			h.Write(c)
+		} else {
+			h.Write(p)
+		}
+
+		c = h.Sum(nil)
+	}
+
+	return c
+}
+
+// repeat returns n bytes made of b repeated.
+func repeat(b []byte, n int) []byte {
+	result := make([]byte, 0, n)
+
+	for len(result) < n {
+		if n-len(result) < len(b) {
+			return append(result, b[:n-len(result)]...)
+		}
+
+		result = append(result, b...)
+	}
+
+	return result
+}
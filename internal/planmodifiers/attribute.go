@@ -39,6 +39,46 @@ func (d *defaultValueAttributePlanModifier) Modify(ctx context.Context, req tfsd
 	resp.AttributePlan = d.val
 }
 
+// DefaultValueOnCreate accepts an attr.Value and uses the supplied value to set a default if the config
+// for the attribute is null and the resource is being created. Existing resources retain the value in
+// state, so that changing the default does not alter them.
+func DefaultValueOnCreate(val attr.Value) tfsdk.AttributePlanModifier {
+	return &defaultValueOnCreateAttributePlanModifier{val}
+}
+
+type defaultValueOnCreateAttributePlanModifier struct {
+	val attr.Value
+}
+
+func (d *defaultValueOnCreateAttributePlanModifier) Description(ctx context.Context) string {
+	return "If the config does not contain a value when the resource is created, a default will be set using val."
+}
+
+func (d *defaultValueOnCreateAttributePlanModifier) MarkdownDescription(ctx context.Context) string {
+	return d.Description(ctx)
+}
+
+// Modify assigns the default value if the value in the config is null and there is no prior state.
+// Otherwise, a null config value results in the value in state being used.
+func (d *defaultValueOnCreateAttributePlanModifier) Modify(ctx context.Context, req tfsdk.ModifyAttributePlanRequest, resp *tfsdk.ModifyAttributePlanResponse) {
+	// Do not set default if the attribute configuration has been set.
+	if !req.AttributeConfig.IsNull() {
+		return
+	}
+
+	// Plan is null when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		resp.AttributePlan = d.val
+		return
+	}
+
+	resp.AttributePlan = req.AttributeState
+}
+
 // RequiresReplace returns an attribute plan modifier that is identical to resource.RequiresReplace() with
 // the exception that there is no check for `configRaw.IsNull && attrSchema.Computed` as a replacement
 // needs to be triggered when the attribute has been removed from the config.
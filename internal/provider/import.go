@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+// importKeepers returns the keepers of a resource imported with an import ID containing keepers, which are
+// null if keepers is nil.
+func importKeepers(keepers map[string]string) types.Map {
+	if keepers == nil {
+		return types.Map{ElemType: types.StringType, Null: true}
+	}
+
+	elems := make(map[string]attr.Value, len(keepers))
+
+	for k, v := range keepers {
+		elems[k] = types.String{Value: v}
+	}
+
+	return types.Map{ElemType: types.StringType, Elems: elems}
+}
+
+// importStringList returns a list of the strings in elems, read from an import ID.
+func importStringList(elems []string) types.List {
+	list := types.List{ElemType: types.StringType, Elems: make([]attr.Value, 0, len(elems))}
+
+	for _, elem := range elems {
+		list.Elems = append(list.Elems, types.String{Value: elem})
+	}
+
+	return list
+}
+
+// isSeedAlgorithm returns whether algorithm is one of random.SeedAlgorithms.
+func isSeedAlgorithm(algorithm string) bool {
+	for _, a := range random.SeedAlgorithms {
+		if a == algorithm {
+			return true
+		}
+	}
+
+	return false
+}
+
+// importSeedAlgorithm returns the seed algorithm with which seed reproduces the result of an imported
+// resource, as reported by reproduces. If algorithm is set, only it is tried. Otherwise each of
+// random.SeedAlgorithms is tried in turn, as the result may have been generated by any provider version. If
+// no algorithm reproduces the result, a warning is added and algorithm, or random.SeedAlgorithmV1 if it is
+// not set, is returned.
+func importSeedAlgorithm(seed string, algorithm *string, reproduces func(random.Generator) (bool, error)) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	fallback := random.SeedAlgorithmV1
+	algorithms := random.SeedAlgorithms
+	tried := "any seed_algorithm"
+
+	if algorithm != nil {
+		fallback = *algorithm
+		algorithms = []string{*algorithm}
+		tried = fmt.Sprintf("the %s seed_algorithm", *algorithm)
+	}
+
+	for _, a := range algorithms {
+		generator, err := random.NewSeededGenerator(a, seed)
+		if err != nil {
+			diags.Append(randError(err)...)
+			return fallback, diags
+		}
+
+		ok, err := reproduces(generator)
+		if err != nil {
+			diags.Append(randError(err)...)
+			return fallback, diags
+		}
+
+		if ok {
+			return a, diags
+		}
+	}
+
+	diags.AddWarning(
+		"Imported Result Differs From Seed",
+		fmt.Sprintf("The seed with %s produces a different result to the one imported. The imported result "+
+			"is kept, but a different result will be generated if the resource is replaced.", tried),
+	)
+
+	return fallback, diags
+}
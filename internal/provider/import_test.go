@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+func TestImportSeedAlgorithm(t *testing.T) {
+	t.Parallel()
+
+	v1, v2 := random.SeedAlgorithmV1, random.SeedAlgorithmV2
+
+	// reproducedBy returns a function reporting whether a generator produces the first value produced
+	// by the generator of algorithm, or by no algorithm if algorithm is empty.
+	reproducedBy := func(t *testing.T, algorithm string) func(random.Generator) (bool, error) {
+		expected := -1
+
+		if algorithm != "" {
+			generator, err := random.NewSeededGenerator(algorithm, "seed")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			expected, err = generator.Intn(1000000)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		}
+
+		return func(generator random.Generator) (bool, error) {
+			got, err := generator.Intn(1000000)
+
+			return got == expected, err
+		}
+	}
+
+	testCases := map[string]struct {
+		algorithm        *string
+		reproducedBy     string
+		expected         string
+		expectedWarnings int
+	}{
+		"inferred-v1": {
+			reproducedBy: v1,
+			expected:     v1,
+		},
+		"inferred-v2": {
+			reproducedBy: v2,
+			expected:     v2,
+		},
+		"inferred-none": {
+			expected:         v1,
+			expectedWarnings: 1,
+		},
+		"given": {
+			algorithm:    &v2,
+			reproducedBy: v2,
+			expected:     v2,
+		},
+		"given-differs": {
+			algorithm:        &v1,
+			reproducedBy:     v2,
+			expected:         v1,
+			expectedWarnings: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := importSeedAlgorithm("seed", testCase.algorithm, reproducedBy(t, testCase.reproducedBy))
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %s", diags)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+
+			if warnings := len(diags.Warnings()); warnings != testCase.expectedWarnings {
+				t.Errorf("expected %d warnings, got: %d", testCase.expectedWarnings, warnings)
+			}
+		})
+	}
+}
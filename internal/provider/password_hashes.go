@@ -0,0 +1,453 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/passwordhash"
+)
+
+// The default options of Argon2id and PBKDF2-SHA256 follow the OWASP Password Storage Cheat
+// Sheet. scrypt uses a lower cost than recommended there, limiting its memory use to 32 MiB,
+// and SHA-512 crypt uses the default rounds of glibc. The PostgreSQL SCRAM-SHA-256 iterations
+// are the default of PostgreSQL.
+const (
+	defaultArgon2idMemory         = 19456
+	defaultArgon2idIterations     = 2
+	defaultArgon2idParallelism    = 1
+	defaultScryptCost             = 15
+	defaultScryptBlockSize        = 8
+	defaultScryptParallelism      = 1
+	defaultPBKDF2SHA256Iterations = 600000
+	defaultSHA512CryptRounds      = 5000
+	defaultPostgreSQLIterations   = 4096
+)
+
+// passwordHashAlgorithms generate each of the hashes in the hashes attribute of
+// random_password.
+var passwordHashAlgorithms = map[string]func(source io.Reader, password string, options hashOptions) (string, error){
+	"argon2id": func(source io.Reader, password string, options hashOptions) (string, error) {
+		salt, err := passwordhash.Salt(source)
+		if err != nil {
+			return "", err
+		}
+
+		return passwordhash.Argon2id(password, salt, options.argon2id), nil
+	},
+	"mysql_caching_sha2_password": func(source io.Reader, password string, options hashOptions) (string, error) {
+		salt, err := passwordhash.Salt(source)
+		if err != nil {
+			return "", err
+		}
+
+		return passwordhash.MySQLCachingSHA2Password(password, passwordhash.CryptSalt(salt, passwordhash.MySQLCachingSHA2SaltLength))
+	},
+	"mysql_native_password": func(source io.Reader, password string, options hashOptions) (string, error) {
+		return passwordhash.MySQLNativePassword(password), nil
+	},
+	"pbkdf2_sha256": func(source io.Reader, password string, options hashOptions) (string, error) {
+		salt, err := passwordhash.Salt(source)
+		if err != nil {
+			return "", err
+		}
+
+		return passwordhash.PBKDF2SHA256(password, salt, options.pbkdf2SHA256Iterations), nil
+	},
+	"postgresql_scram_sha256": func(source io.Reader, password string, options hashOptions) (string, error) {
+		salt, err := passwordhash.Salt(source)
+		if err != nil {
+			return "", err
+		}
+
+		return passwordhash.PostgreSQLSCRAMSHA256(password, salt, options.postgreSQLIterations), nil
+	},
+	"scrypt": func(source io.Reader, password string, options hashOptions) (string, error) {
+		salt, err := passwordhash.Salt(source)
+		if err != nil {
+			return "", err
+		}
+
+		return passwordhash.Scrypt(password, salt, options.scrypt)
+	},
+	"sha512_crypt": func(source io.Reader, password string, options hashOptions) (string, error) {
+		salt, err := passwordhash.Salt(source)
+		if err != nil {
+			return "", err
+		}
+
+		return passwordhash.SHA512Crypt(password, passwordhash.CryptSalt(salt, 16), options.sha512CryptRounds), nil
+	},
+}
+
+// passwordHashAlgorithmNames returns the names of all password hash algorithms, sorted
+// alphabetically, so that salts are read in the same order for each resource.
+func passwordHashAlgorithmNames() []string {
+	names := make([]string, 0, len(passwordHashAlgorithms))
+
+	for name := range passwordHashAlgorithms {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// passwordHashesAttrTypes are the attribute types of the hashes attribute of random_password.
+var passwordHashesAttrTypes = func() map[string]attr.Type {
+	attrTypes := make(map[string]attr.Type, len(passwordHashAlgorithms))
+
+	for name := range passwordHashAlgorithms {
+		attrTypes[name] = types.StringType
+	}
+
+	return attrTypes
+}()
+
+// passwordHashesAttribute returns the hashes attribute of random_password.
+func passwordHashesAttribute() tfsdk.Attribute {
+	return tfsdk.Attribute{
+		Description: "Hashes of the generated random string, which may be used to seed the password of an " +
+			"operating system user, database role, web framework or identity provider. Only the hashes of the " +
+			"`algorithms` of the `hash_options` block are generated, and the others are null. `hashes` is null " +
+			"if there is no `hash_options` block. Each hash has a salt drawn from the provider's " +
+			"`entropy_source` and is generated when the resource is created, or when `hash_options` change. " +
+			"`argon2id`, `pbkdf2_sha256` and `scrypt` are in the PHC string format, `sha512_crypt` is in " +
+			"the crypt(3) format used by `/etc/shadow`, and `postgresql_scram_sha256`, `mysql_native_password` " +
+			"and `mysql_caching_sha2_password` are in the formats stored by PostgreSQL and MySQL.",
+		Type: types.ObjectType{
+			AttrTypes: passwordHashesAttrTypes,
+		},
+		Computed:  true,
+		Sensitive: true,
+	}
+}
+
+// hashOptionsBlock returns the hash_options block of random_password.
+func hashOptionsBlock() tfsdk.Block {
+	return tfsdk.Block{
+		Description: "The algorithms and parameters of the hashes in `hashes`. Changing this block regenerates " +
+			"`hashes`, but not the result.",
+		NestingMode: tfsdk.BlockNestingModeList,
+		Validators: []tfsdk.AttributeValidator{
+			listvalidator.SizeAtMost(1),
+		},
+		Attributes: map[string]tfsdk.Attribute{
+			"algorithms": {
+				Description: "The algorithms of the hashes to generate. The allowed values are " +
+					"`" + strings.Join(passwordHashAlgorithmNames(), "`, `") + "`. Argon2id, scrypt and PBKDF2-SHA256 " +
+					"are deliberately expensive, so only the hashes which are used should be generated.",
+				Type: types.SetType{
+					ElemType: types.StringType,
+				},
+				Required: true,
+				Validators: []tfsdk.AttributeValidator{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValuesAre(stringvalidator.OneOf(passwordHashAlgorithmNames()...)),
+				},
+			},
+			"argon2id_memory": {
+				Description: fmt.Sprintf("The memory used by Argon2id, in KiB. Must be at least 8 times "+
+					"`argon2id_parallelism`. Default value is `%d`.", defaultArgon2idMemory),
+				Type:     types.Int64Type,
+				Optional: true,
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.Between(8, 1<<32-1),
+				},
+			},
+			"argon2id_iterations": {
+				Description: fmt.Sprintf("The number of iterations of Argon2id. Default value is `%d`.",
+					defaultArgon2idIterations),
+				Type:     types.Int64Type,
+				Optional: true,
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.Between(1, 1<<32-1),
+				},
+			},
+			"argon2id_parallelism": {
+				Description: fmt.Sprintf("The degree of parallelism of Argon2id. Default value is `%d`.",
+					defaultArgon2idParallelism),
+				Type:     types.Int64Type,
+				Optional: true,
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.Between(1, 255),
+				},
+			},
+			"scrypt_cost": {
+				Description: fmt.Sprintf("The base 2 logarithm of the CPU/memory cost parameter (N) of scrypt. "+
+					"Default value is `%d`.", defaultScryptCost),
+				Type:     types.Int64Type,
+				Optional: true,
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.Between(1, 30),
+				},
+			},
+			"scrypt_block_size": {
+				Description: fmt.Sprintf("The block size parameter (r) of scrypt. Default value is `%d`.",
+					defaultScryptBlockSize),
+				Type:     types.Int64Type,
+				Optional: true,
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.Between(1, 1<<20),
+				},
+			},
+			"scrypt_parallelism": {
+				Description: fmt.Sprintf("The parallelization parameter (p) of scrypt. Default value is `%d`.",
+					defaultScryptParallelism),
+				Type:     types.Int64Type,
+				Optional: true,
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.Between(1, 1<<20),
+				},
+			},
+			"pbkdf2_sha256_iterations": {
+				Description: fmt.Sprintf("The number of iterations of PBKDF2-SHA256. Default value is `%d`.",
+					defaultPBKDF2SHA256Iterations),
+				Type:     types.Int64Type,
+				Optional: true,
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.Between(1, 1<<31-1),
+				},
+			},
+			"postgresql_scram_sha256_iterations": {
+				Description: fmt.Sprintf("The number of iterations of PostgreSQL SCRAM-SHA-256. Default value is `%d`.",
+					defaultPostgreSQLIterations),
+				Type:     types.Int64Type,
+				Optional: true,
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.Between(1, 1<<31-1),
+				},
+			},
+			"sha512_crypt_rounds": {
+				Description: fmt.Sprintf("The number of rounds of SHA-512 crypt. Default value is `%d`.",
+					defaultSHA512CryptRounds),
+				Type:     types.Int64Type,
+				Optional: true,
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.Between(1000, 999999999),
+				},
+			},
+		},
+	}
+}
+
+type hashOptionsModel struct {
+	Algorithms             types.Set   `tfsdk:"algorithms"`
+	Argon2idMemory         types.Int64 `tfsdk:"argon2id_memory"`
+	Argon2idIterations     types.Int64 `tfsdk:"argon2id_iterations"`
+	Argon2idParallelism    types.Int64 `tfsdk:"argon2id_parallelism"`
+	ScryptCost             types.Int64 `tfsdk:"scrypt_cost"`
+	ScryptBlockSize        types.Int64 `tfsdk:"scrypt_block_size"`
+	ScryptParallelism      types.Int64 `tfsdk:"scrypt_parallelism"`
+	PBKDF2SHA256Iterations types.Int64 `tfsdk:"pbkdf2_sha256_iterations"`
+	SHA512CryptRounds      types.Int64 `tfsdk:"sha512_crypt_rounds"`
+	PostgreSQLIterations   types.Int64 `tfsdk:"postgresql_scram_sha256_iterations"`
+}
+
+// hashOptions are the parameters of each password hash algorithm.
+type hashOptions struct {
+	argon2id               passwordhash.Argon2idParams
+	scrypt                 passwordhash.ScryptParams
+	pbkdf2SHA256Iterations int
+	sha512CryptRounds      int
+	postgreSQLIterations   int
+}
+
+// newHashOptions returns the parameters configured by the hash_options block, using
+// the default value of any parameter which is not set.
+func newHashOptions(models []hashOptionsModel) hashOptions {
+	model := hashOptionsModel{
+		Argon2idMemory:         types.Int64{Null: true},
+		Argon2idIterations:     types.Int64{Null: true},
+		Argon2idParallelism:    types.Int64{Null: true},
+		ScryptCost:             types.Int64{Null: true},
+		ScryptBlockSize:        types.Int64{Null: true},
+		ScryptParallelism:      types.Int64{Null: true},
+		PBKDF2SHA256Iterations: types.Int64{Null: true},
+		SHA512CryptRounds:      types.Int64{Null: true},
+		PostgreSQLIterations:   types.Int64{Null: true},
+	}
+
+	if len(models) > 0 {
+		model = models[0]
+	}
+
+	valueOrDefault := func(v types.Int64, defaultValue int64) int64 {
+		if v.IsNull() || v.IsUnknown() {
+			return defaultValue
+		}
+
+		return v.Value
+	}
+
+	return hashOptions{
+		argon2id: passwordhash.Argon2idParams{
+			Memory:      uint32(valueOrDefault(model.Argon2idMemory, defaultArgon2idMemory)),
+			Iterations:  uint32(valueOrDefault(model.Argon2idIterations, defaultArgon2idIterations)),
+			Parallelism: uint8(valueOrDefault(model.Argon2idParallelism, defaultArgon2idParallelism)),
+		},
+		scrypt: passwordhash.ScryptParams{
+			Cost:        int(valueOrDefault(model.ScryptCost, defaultScryptCost)),
+			BlockSize:   int(valueOrDefault(model.ScryptBlockSize, defaultScryptBlockSize)),
+			Parallelism: int(valueOrDefault(model.ScryptParallelism, defaultScryptParallelism)),
+		},
+		pbkdf2SHA256Iterations: int(valueOrDefault(model.PBKDF2SHA256Iterations, defaultPBKDF2SHA256Iterations)),
+		sha512CryptRounds:      int(valueOrDefault(model.SHA512CryptRounds, defaultSHA512CryptRounds)),
+		postgreSQLIterations:   int(valueOrDefault(model.PostgreSQLIterations, defaultPostgreSQLIterations)),
+	}
+}
+
+// generatePasswordHashes returns the hashes of password with each of the algorithms of the
+// hash_options block, reading their salts from source. The hashes of other algorithms are null,
+// and hashes is null if there is no hash_options block.
+func generatePasswordHashes(source io.Reader, password string, options []hashOptionsModel) (types.Object, error) {
+	if len(options) == 0 {
+		return types.Object{AttrTypes: passwordHashesAttrTypes, Null: true}, nil
+	}
+
+	requested := make(map[string]bool, len(options[0].Algorithms.Elems))
+
+	for _, elem := range options[0].Algorithms.Elems {
+		if algorithm, ok := elem.(types.String); ok {
+			requested[algorithm.Value] = true
+		}
+	}
+
+	result := types.Object{
+		AttrTypes: passwordHashesAttrTypes,
+		Attrs:     make(map[string]attr.Value, len(passwordHashAlgorithms)),
+	}
+
+	for _, name := range passwordHashAlgorithmNames() {
+		if !requested[name] {
+			result.Attrs[name] = types.String{Null: true}
+			continue
+		}
+
+		hash, err := passwordHashAlgorithms[name](source, password, newHashOptions(options))
+		if err != nil {
+			return types.Object{}, fmt.Errorf("%s: %w", name, err)
+		}
+
+		result.Attrs[name] = types.String{Value: hash}
+	}
+
+	return result, nil
+}
+
+// bcryptCost returns the configured bcrypt cost, or the default cost if it is not set.
+func bcryptCost(cost types.Int64) int {
+	if cost.IsNull() || cost.IsUnknown() {
+		return bcrypt.DefaultCost
+	}
+
+	return int(cost.Value)
+}
+
+// modifyBcryptHashPlan plans bcrypt_hash as unknown, so that it is regenerated from the result
+// by Update, when the cost of the bcrypt_hash in state differs from bcrypt_cost. Comparing with
+// the cost of the hash itself, rather than bcrypt_cost in state, means that resources created
+// before bcrypt_cost was introduced are only updated if their hash does not have the default cost.
+func modifyBcryptHashPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var cost types.Int64
+	var hash types.String
+
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("bcrypt_cost"), &cost)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("bcrypt_hash"), &hash)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !cost.IsUnknown() {
+		if stateCost, err := bcrypt.Cost([]byte(hash.Value)); err == nil && stateCost == bcryptCost(cost) {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("bcrypt_hash"), types.String{Unknown: true})...)
+}
+
+// modifyPasswordHashesPlan validates the hash_options block, and sets the planned value
+// of hashes to null if there is no hash_options block, or to the value in state unless
+// hash_options have changed, in which case hashes are planned as unknown and regenerated
+// by Update.
+func modifyPasswordHashesPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planOptionsValue attr.Value
+	var planOptions []hashOptionsModel
+
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("hash_options"), &planOptionsValue)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("hash_options"), &planOptions)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// No hashes are generated without a hash_options block.
+	if isEmptyList(planOptionsValue) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("hashes"), types.Object{AttrTypes: passwordHashesAttrTypes, Null: true})...)
+
+		return
+	}
+
+	for i, options := range planOptions {
+		if options.Argon2idMemory.IsUnknown() || options.Argon2idParallelism.IsUnknown() {
+			continue
+		}
+
+		params := newHashOptions([]hashOptionsModel{options}).argon2id
+
+		if params.Memory < 8*uint32(params.Parallelism) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("hash_options").AtListIndex(i).AtName("argon2id_memory"),
+				"Invalid Argon2id Memory",
+				fmt.Sprintf("argon2id_memory must be at least 8 times argon2id_parallelism (%d), got: %d.",
+					params.Parallelism, params.Memory),
+			)
+		}
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var stateOptionsValue attr.Value
+	var stateHashes types.Object
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("hash_options"), &stateOptionsValue)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("hashes"), &stateHashes)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if stateHashes.IsNull() {
+		return
+	}
+
+	if planOptionsValue.Equal(stateOptionsValue) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("hashes"), stateHashes)...)
+	}
+}
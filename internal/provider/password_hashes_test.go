@@ -0,0 +1,409 @@
+package provider
+
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/passwordhash"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+func TestGeneratePasswordHashes(t *testing.T) {
+	t.Parallel()
+
+	algorithms := types.Set{ElemType: types.StringType}
+
+	for _, name := range passwordHashAlgorithmNames() {
+		if name != "scrypt" {
+			algorithms.Elems = append(algorithms.Elems, types.String{Value: name})
+		}
+	}
+
+	options := []hashOptionsModel{
+		{
+			Algorithms:             algorithms,
+			Argon2idMemory:         types.Int64{Value: 64},
+			Argon2idIterations:     types.Int64{Value: 1},
+			Argon2idParallelism:    types.Int64{Null: true},
+			ScryptCost:             types.Int64{Value: 4},
+			ScryptBlockSize:        types.Int64{Null: true},
+			ScryptParallelism:      types.Int64{Null: true},
+			PBKDF2SHA256Iterations: types.Int64{Value: 1000},
+			SHA512CryptRounds:      types.Int64{Value: 1000},
+			PostgreSQLIterations:   types.Int64{Value: 1000},
+		},
+	}
+
+	hashes, err := generatePasswordHashes(random.NewReproducibleEntropySource("TestGeneratePasswordHashes"), "password", options)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for name := range passwordHashAlgorithms {
+		hash, ok := hashes.Attrs[name].(types.String)
+		if !ok {
+			t.Fatalf("%s: missing hash", name)
+		}
+
+		// Only the hashes of the requested algorithms are generated.
+		if name == "scrypt" {
+			if !hash.IsNull() {
+				t.Errorf("%s: expected null hash, got: %s", name, hash.Value)
+			}
+
+			continue
+		}
+
+		if err := testPasswordHashValid(name, hash.Value, "password"); err != nil {
+			t.Error(err)
+		}
+	}
+
+	// Salts are read from the source in the same order each time.
+	regenerated, err := generatePasswordHashes(random.NewReproducibleEntropySource("TestGeneratePasswordHashes"), "password", options)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !regenerated.Equal(hashes) {
+		t.Errorf("expected hashes generated from the same source to be equal")
+	}
+
+	none, err := generatePasswordHashes(random.NewReproducibleEntropySource("TestGeneratePasswordHashes"), "password", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !none.IsNull() {
+		t.Errorf("expected null hashes without hash_options, got: %s", none)
+	}
+}
+
+func TestAccResourcePassword_Hashes(t *testing.T) {
+	var result1, result2, sha512Crypt string
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_password.test", "result", &result1),
+					resource.TestCheckNoResourceAttr("random_password.test", "hashes.argon2id"),
+				),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+
+							hash_options {
+								algorithms = ["argon2id", "mysql_caching_sha2_password", "mysql_native_password", "pbkdf2_sha256", "postgresql_scram_sha256", "scrypt", "sha512_crypt"]
+							}
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_password.test", "result", &result2),
+					testCheckAttributeValuesEqual(&result1, &result2),
+					testCheckPasswordHashesValid("random_password.test"),
+					resource.TestMatchResourceAttr("random_password.test", "hashes.argon2id", regexp.MustCompile(`^\$argon2id\$v=19\$m=19456,t=2,p=1\$`)),
+					resource.TestMatchResourceAttr("random_password.test", "hashes.mysql_caching_sha2_password", regexp.MustCompile(`^\$A\$005\$.{20}[./0-9A-Za-z]{43}$`)),
+					resource.TestMatchResourceAttr("random_password.test", "hashes.mysql_native_password", regexp.MustCompile(`^\*[0-9A-F]{40}$`)),
+					resource.TestMatchResourceAttr("random_password.test", "hashes.pbkdf2_sha256", regexp.MustCompile(`^\$pbkdf2-sha256\$i=600000\$`)),
+					resource.TestMatchResourceAttr("random_password.test", "hashes.postgresql_scram_sha256", regexp.MustCompile(`^SCRAM-SHA-256\$4096:`)),
+					resource.TestMatchResourceAttr("random_password.test", "hashes.scrypt", regexp.MustCompile(`^\$scrypt\$ln=15,r=8,p=1\$`)),
+					resource.TestMatchResourceAttr("random_password.test", "hashes.sha512_crypt", regexp.MustCompile(`^\$6\$[./0-9A-Za-z]{16}\$`)),
+				),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+
+							hash_options {
+								algorithms                         = ["argon2id", "pbkdf2_sha256", "postgresql_scram_sha256", "scrypt", "sha512_crypt"]
+								argon2id_memory                    = 1024
+								argon2id_iterations                = 1
+								argon2id_parallelism               = 2
+								scrypt_cost                        = 10
+								scrypt_block_size                  = 4
+								scrypt_parallelism                 = 2
+								pbkdf2_sha256_iterations           = 1000
+								sha512_crypt_rounds                = 10000
+								postgresql_scram_sha256_iterations = 1000
+							}
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_password.test", "result", &result2),
+					testCheckAttributeValuesEqual(&result1, &result2),
+					testExtractResourceAttr("random_password.test", "hashes.sha512_crypt", &sha512Crypt),
+					testCheckPasswordHashesValid("random_password.test"),
+					resource.TestMatchResourceAttr("random_password.test", "hashes.argon2id", regexp.MustCompile(`^\$argon2id\$v=19\$m=1024,t=1,p=2\$`)),
+					resource.TestMatchResourceAttr("random_password.test", "hashes.pbkdf2_sha256", regexp.MustCompile(`^\$pbkdf2-sha256\$i=1000\$`)),
+					resource.TestMatchResourceAttr("random_password.test", "hashes.postgresql_scram_sha256", regexp.MustCompile(`^SCRAM-SHA-256\$1000:`)),
+					resource.TestMatchResourceAttr("random_password.test", "hashes.scrypt", regexp.MustCompile(`^\$scrypt\$ln=10,r=4,p=2\$`)),
+					resource.TestMatchResourceAttr("random_password.test", "hashes.sha512_crypt", regexp.MustCompile(`^\$6\$rounds=10000\$`)),
+				),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+
+							hash_options {
+								algorithms                         = ["argon2id", "pbkdf2_sha256", "postgresql_scram_sha256", "scrypt", "sha512_crypt"]
+								argon2id_memory                    = 1024
+								argon2id_iterations                = 1
+								argon2id_parallelism               = 2
+								scrypt_cost                        = 10
+								scrypt_block_size                  = 4
+								scrypt_parallelism                 = 2
+								pbkdf2_sha256_iterations           = 1000
+								sha512_crypt_rounds                = 10000
+								postgresql_scram_sha256_iterations = 1000
+							}
+						}`,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_HashOptions_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+
+							hash_options {
+								algorithms           = ["argon2id"]
+								argon2id_memory      = 16
+								argon2id_parallelism = 4
+							}
+						}`,
+				ExpectError: regexp.MustCompile(`argon2id_memory must be at least 8 times argon2id_parallelism`),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+
+							hash_options {
+								algorithms          = ["sha512_crypt"]
+								sha512_crypt_rounds = 999
+							}
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute hash_options\[0\].sha512_crypt_rounds value must be between`),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+
+							hash_options {
+								algorithms = ["md5_crypt"]
+							}
+						}`,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
+
+// TestAccResourcePassword_Hashes_FromVersion3_4_2 verifies that resources created before hashes
+// were introduced are unchanged, and that hashes are generated without changing the result when
+// a hash_options block is added.
+func TestAccResourcePassword_Hashes_FromVersion3_4_2(t *testing.T) {
+	var result1, result2 string
+
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: providerVersion342(),
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_password.test", "result", &result1),
+				),
+			},
+			{
+				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+				PlanOnly: true,
+			},
+			{
+				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				Config: `resource "random_password" "test" {
+							length = 12
+
+							hash_options {
+								algorithms = ["sha512_crypt"]
+							}
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_password.test", "result", &result2),
+					testCheckAttributeValuesEqual(&result1, &result2),
+					testCheckPasswordHashesValid("random_password.test"),
+					resource.TestCheckResourceAttrSet("random_password.test", "hashes.sha512_crypt"),
+					resource.TestCheckNoResourceAttr("random_password.test", "hashes.argon2id"),
+				),
+			},
+		},
+	})
+}
+
+// testCheckPasswordHashesValid verifies each hash which is set in the hashes attribute of a
+// random_password resource against its result.
+func testCheckPasswordHashesValid(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource name %s not found in state", resourceName)
+		}
+
+		for name := range passwordHashAlgorithms {
+			hash, ok := rs.Primary.Attributes["hashes."+name]
+			if !ok || hash == "" {
+				continue
+			}
+
+			if err := testPasswordHashValid(name, hash, rs.Primary.Attributes["result"]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// testPasswordHashValid generates the hash of password again, with the salt and parameters
+// of hash, and compares it to hash.
+func testPasswordHashValid(name, hash, password string) error {
+	fields := strings.Split(hash, "$")
+
+	var expected string
+
+	switch name {
+	case "argon2id":
+		var params passwordhash.Argon2idParams
+
+		if len(fields) != 6 {
+			return fmt.Errorf("%s: unexpected format: %s", name, hash)
+		}
+
+		if _, err := fmt.Sscanf(fields[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		salt, err := base64.RawStdEncoding.DecodeString(fields[4])
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		expected = passwordhash.Argon2id(password, salt, params)
+	case "mysql_caching_sha2_password":
+		if len(hash) < 7+passwordhash.MySQLCachingSHA2SaltLength {
+			return fmt.Errorf("%s: unexpected format: %s", name, hash)
+		}
+
+		var err error
+
+		if expected, err = passwordhash.MySQLCachingSHA2Password(password, hash[7:7+passwordhash.MySQLCachingSHA2SaltLength]); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	case "mysql_native_password":
+		expected = passwordhash.MySQLNativePassword(password)
+	case "pbkdf2_sha256":
+		var iterations int
+
+		if len(fields) != 5 {
+			return fmt.Errorf("%s: unexpected format: %s", name, hash)
+		}
+
+		if _, err := fmt.Sscanf(fields[2], "i=%d", &iterations); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		salt, err := base64.RawStdEncoding.DecodeString(fields[3])
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		expected = passwordhash.PBKDF2SHA256(password, salt, iterations)
+	case "postgresql_scram_sha256":
+		if len(fields) != 3 {
+			return fmt.Errorf("%s: unexpected format: %s", name, hash)
+		}
+
+		params := strings.SplitN(fields[1], ":", 2)
+		if len(params) != 2 {
+			return fmt.Errorf("%s: unexpected format: %s", name, hash)
+		}
+
+		iterations, err := strconv.Atoi(params[0])
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		salt, err := base64.StdEncoding.DecodeString(params[1])
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		expected = passwordhash.PostgreSQLSCRAMSHA256(password, salt, iterations)
+	case "scrypt":
+		var params passwordhash.ScryptParams
+
+		if len(fields) != 5 {
+			return fmt.Errorf("%s: unexpected format: %s", name, hash)
+		}
+
+		if _, err := fmt.Sscanf(fields[2], "ln=%d,r=%d,p=%d", &params.Cost, &params.BlockSize, &params.Parallelism); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		salt, err := base64.RawStdEncoding.DecodeString(fields[3])
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		if expected, err = passwordhash.Scrypt(password, salt, params); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	case "sha512_crypt":
+		rounds := 5000
+
+		if len(fields) == 5 {
+			var err error
+
+			if rounds, err = strconv.Atoi(strings.TrimPrefix(fields[2], "rounds=")); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+
+			fields = append(fields[:2], fields[3:]...)
+		}
+
+		if len(fields) != 4 {
+			return fmt.Errorf("%s: unexpected format: %s", name, hash)
+		}
+
+		expected = passwordhash.SHA512Crypt(password, fields[2], rounds)
+	default:
+		return fmt.Errorf("%s: unknown hash", name)
+	}
+
+	if hash != expected {
+		return fmt.Errorf("%s: expected %s, got: %s", name, expected, hash)
+	}
+
+	return nil
+}
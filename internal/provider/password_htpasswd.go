@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/passwordhash"
+)
+
+const defaultHtpasswdFormat = "bcrypt"
+
+// htpasswdFormats generate the hash of htpasswd_line for each value of htpasswd_format, reading
+// any salt from source. The bcrypt format uses bcrypt_hash, so that both are regenerated together
+// when bcrypt_cost changes.
+var htpasswdFormats = map[string]func(source io.Reader, password, bcryptHash string) (string, error){
+	"apr1": func(source io.Reader, password, _ string) (string, error) {
+		salt, err := passwordhash.Salt(source)
+		if err != nil {
+			return "", err
+		}
+
+		return passwordhash.APR1(password, passwordhash.CryptSalt(salt, passwordhash.APR1SaltLength)), nil
+	},
+	"bcrypt": func(_ io.Reader, _, bcryptHash string) (string, error) {
+		return bcryptHash, nil
+	},
+	"sha1": func(_ io.Reader, password, _ string) (string, error) {
+		return passwordhash.HtpasswdSHA1(password), nil
+	},
+}
+
+func htpasswdFormatNames() []string {
+	names := make([]string, 0, len(htpasswdFormats))
+
+	for name := range htpasswdFormats {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// htpasswdFormat returns the configured htpasswd format, or the default format if it is not set.
+func htpasswdFormat(format types.String) string {
+	if format.IsNull() || format.IsUnknown() {
+		return defaultHtpasswdFormat
+	}
+
+	return format.Value
+}
+
+// generateHtpasswdLine returns the htpasswd line of username, or null if username is null. Any
+// salt is read from source.
+func generateHtpasswdLine(source io.Reader, password string, username, format, bcryptHash types.String) (types.String, error) {
+	if username.IsNull() {
+		return types.String{Null: true}, nil
+	}
+
+	hash, err := htpasswdFormats[htpasswdFormat(format)](source, password, bcryptHash.Value)
+	if err != nil {
+		return types.String{}, err
+	}
+
+	return types.String{Value: username.Value + ":" + hash}, nil
+}
+
+// modifyHtpasswdLinePlan sets the planned value of htpasswd_line to null when htpasswd_username
+// is not set, or to the value in state unless htpasswd_username, htpasswd_format or, for the
+// bcrypt format, bcrypt_hash have changed, in which case htpasswd_line is planned as unknown
+// and regenerated by Update. It must be called after modifyBcryptHashPlan.
+func modifyHtpasswdLinePlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var username, format, bcryptHash types.String
+
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("htpasswd_username"), &username)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("htpasswd_format"), &format)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("bcrypt_hash"), &bcryptHash)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if username.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("htpasswd_line"), types.String{Null: true})...)
+		return
+	}
+
+	if req.State.Raw.IsNull() || username.IsUnknown() || format.IsUnknown() {
+		return
+	}
+
+	var stateUsername, stateFormat, stateLine types.String
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("htpasswd_username"), &stateUsername)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("htpasswd_format"), &stateFormat)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("htpasswd_line"), &stateLine)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if stateLine.IsNull() || !username.Equal(stateUsername) || htpasswdFormat(format) != htpasswdFormat(stateFormat) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("htpasswd_line"), types.String{Unknown: true})...)
+		return
+	}
+
+	if htpasswdFormat(format) == "bcrypt" && bcryptHash.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("htpasswd_line"), types.String{Unknown: true})...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("htpasswd_line"), stateLine)...)
+}
@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/passwordhash"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+func TestGenerateHtpasswdLine(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		username types.String
+		format   types.String
+		expected *regexp.Regexp
+	}{
+		"no-username": {
+			username: types.String{Null: true},
+			format:   types.String{Value: "sha1"},
+		},
+		"default": {
+			username: types.String{Value: "alice"},
+			format:   types.String{Null: true},
+			expected: regexp.MustCompile(`^alice:\$2a\$04\$bcrypt$`),
+		},
+		"apr1": {
+			username: types.String{Value: "alice"},
+			format:   types.String{Value: "apr1"},
+			expected: regexp.MustCompile(`^alice:\$apr1\$[./0-9A-Za-z]{8}\$[./0-9A-Za-z]{22}$`),
+		},
+		"sha1": {
+			username: types.String{Value: "alice"},
+			format:   types.String{Value: "sha1"},
+			expected: regexp.MustCompile(`^alice:\{SHA\}W6ph5Mm5Pz8GgiULbPgzG37mj9g=$`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := generateHtpasswdLine(random.NewReproducibleEntropySource("TestGenerateHtpasswdLine", name), "password", testCase.username, testCase.format, types.String{Value: "$2a$04$bcrypt"})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			// Salts are read from the source, so the same source generates the same line.
+			regenerated, err := generateHtpasswdLine(random.NewReproducibleEntropySource("TestGenerateHtpasswdLine", name), "password", testCase.username, testCase.format, types.String{Value: "$2a$04$bcrypt"})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !regenerated.Equal(got) {
+				t.Errorf("expected htpasswd lines generated from the same source to be equal, got: %s and %s", got, regenerated)
+			}
+
+			if testCase.expected == nil {
+				if !got.IsNull() {
+					t.Errorf("expected null, got: %s", got)
+				}
+
+				return
+			}
+
+			if !testCase.expected.MatchString(got.Value) {
+				t.Errorf("expected to match %s, got: %s", testCase.expected, got.Value)
+			}
+		})
+	}
+}
+
+func TestAccResourcePassword_Htpasswd(t *testing.T) {
+	var result1, result2 string
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_password.test", "result", &result1),
+					resource.TestCheckNoResourceAttr("random_password.test", "htpasswd_line"),
+				),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length            = 12
+							htpasswd_username = "alice"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_password.test", "result", &result2),
+					testCheckAttributeValuesEqual(&result1, &result2),
+					resource.TestMatchResourceAttr("random_password.test", "htpasswd_line", regexp.MustCompile(`^alice:\$2a\$10\$`)),
+					testCheckHtpasswdLineValid("random_password.test", "alice"),
+				),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length            = 12
+							htpasswd_username = "alice"
+							htpasswd_format   = "apr1"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_password.test", "result", &result2),
+					testCheckAttributeValuesEqual(&result1, &result2),
+					resource.TestMatchResourceAttr("random_password.test", "htpasswd_line", regexp.MustCompile(`^alice:\$apr1\$`)),
+					testCheckHtpasswdLineValid("random_password.test", "alice"),
+				),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length            = 12
+							htpasswd_username = "bob"
+							htpasswd_format   = "sha1"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_password.test", "result", &result2),
+					testCheckAttributeValuesEqual(&result1, &result2),
+					resource.TestMatchResourceAttr("random_password.test", "htpasswd_line", regexp.MustCompile(`^bob:\{SHA\}`)),
+					testCheckHtpasswdLineValid("random_password.test", "bob"),
+				),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length            = 12
+							htpasswd_username = "bob"
+							htpasswd_format   = "sha1"
+						}`,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_Htpasswd_BcryptCost(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length            = 12
+							htpasswd_username = "alice"
+						}`,
+				Check: testCheckHtpasswdLineValid("random_password.test", "alice"),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length            = 12
+							bcrypt_cost       = 5
+							htpasswd_username = "alice"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("random_password.test", "htpasswd_line", regexp.MustCompile(`^alice:\$2a\$05\$`)),
+					testCheckHtpasswdLineValid("random_password.test", "alice"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_Htpasswd_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length            = 12
+							htpasswd_username = "alice:admin"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute htpasswd_username must not contain a colon or a line break`),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length            = 12
+							htpasswd_username = "alice"
+							htpasswd_format   = "md5"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute htpasswd_format Value must be one of`),
+			},
+		},
+	})
+}
+
+// testCheckHtpasswdLineValid verifies that the htpasswd_line attribute of a random_password
+// resource authenticates username with its result.
+func testCheckHtpasswdLineValid(resourceName, username string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource name %s not found in state", resourceName)
+		}
+
+		password := rs.Primary.Attributes["result"]
+		line := rs.Primary.Attributes["htpasswd_line"]
+
+		hash := strings.TrimPrefix(line, username+":")
+		if hash == line {
+			return fmt.Errorf("expected htpasswd_line for %s, got: %s", username, line)
+		}
+
+		switch {
+		case strings.HasPrefix(hash, "$apr1$"):
+			fields := strings.Split(hash, "$")
+			if len(fields) != 4 {
+				return fmt.Errorf("unexpected format: %s", hash)
+			}
+
+			if expected := passwordhash.APR1(password, fields[2]); hash != expected {
+				return fmt.Errorf("expected %s, got: %s", expected, hash)
+			}
+		case strings.HasPrefix(hash, "{SHA}"):
+			if expected := passwordhash.HtpasswdSHA1(password); hash != expected {
+				return fmt.Errorf("expected %s, got: %s", expected, hash)
+			}
+		default:
+			if hash != rs.Primary.Attributes["bcrypt_hash"] {
+				return fmt.Errorf("expected bcrypt_hash, got: %s", hash)
+			}
+
+			if err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
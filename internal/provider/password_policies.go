@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+// passwordPolicy is a preset of random_password attribute values which produce passwords
+// accepted by a particular system. Each character class is enabled, and at least one
+// character from each class is required.
+type passwordPolicy struct {
+	// length is the default length of the result.
+	length int64
+
+	// minLength and maxLength are the bounds of the length accepted by the system.
+	minLength int64
+	maxLength int64
+
+	// special are the special characters accepted by the system, excluding any which
+	// commonly require quoting or escaping in the system's clients.
+	special string
+}
+
+// passwordPolicies are the presets which may be selected by the random_password policy
+// attribute.
+var passwordPolicies = map[string]passwordPolicy{
+	// AWS Identity and Access Management user passwords accept 6 to 128 characters. The
+	// minimum length follows the CIS AWS Foundations Benchmark.
+	"aws_iam": {
+		length:    32,
+		minLength: 14,
+		maxLength: 128,
+		special:   "!@#$%^&*()_+-=[]{}|'",
+	},
+	// Azure Active Directory (Microsoft Entra ID) user passwords accept 8 to 256 characters.
+	// The space character is accepted, but is excluded.
+	"azure_ad": {
+		length:    32,
+		minLength: 8,
+		maxLength: 256,
+		special:   "@#$%^&*-_!+=[]{}|\\:',.?/`~\"();<>",
+	},
+	// MySQL passwords, within the 41 character limit of Amazon RDS for MySQL master users.
+	// The characters /, ', " and @ are excluded, as Amazon RDS rejects them.
+	"mysql": {
+		length:    32,
+		minLength: 8,
+		maxLength: 41,
+		special:   "!#$%&*()-_=+[]{}<>:?",
+	},
+	// Oracle Database passwords accept up to 30 bytes. Only the special characters which
+	// are permitted in nonquoted passwords are used, but a nonquoted password must also
+	// begin with a letter, which the result may not, so the password must be quoted.
+	"oracle": {
+		length:    30,
+		minLength: 8,
+		maxLength: 30,
+		special:   "_$#",
+	},
+	// PostgreSQL passwords, within the 128 character limit of Amazon RDS for PostgreSQL master
+	// users. The characters /, ', " and @ are excluded, as Amazon RDS rejects them.
+	"postgresql": {
+		length:    32,
+		minLength: 8,
+		maxLength: 128,
+		special:   "!#$%&*()-_=+[]{}<>:?",
+	},
+	// Windows local account passwords which meet the complexity requirements of the
+	// "Password must meet complexity requirements" security policy setting. The minimum
+	// length follows the Microsoft security baseline.
+	"windows_local": {
+		length:    24,
+		minLength: 14,
+		maxLength: 127,
+		special:   "~!@#$%^&*_-+=`|\\(){}[]:;\"'<>,.?/",
+	},
+}
+
+// passwordPolicyNames returns the names of all password policies, sorted alphabetically.
+func passwordPolicyNames() []string {
+	names := make([]string, 0, len(passwordPolicies))
+
+	for name := range passwordPolicies {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// passwordPolicyClassAttributes are the attributes which enable each character class, and
+// which set the minimum number of characters from the class.
+var passwordPolicyClassAttributes = []struct {
+	enabled string
+	min     string
+}{
+	{"lower", "min_lower"},
+	{"numeric", "min_numeric"},
+	{"special", "min_special"},
+	{"upper", "min_upper"},
+}
+
+// applyPasswordPolicy sets the planned value of each attribute which is null in the resource
+// configuration to the value from the policy. If the policy is unknown, those attributes are
+// planned as unknown.
+func applyPasswordPolicy(ctx context.Context, policyName types.String, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	policy := passwordPolicies[policyName.Value]
+
+	var (
+		length, min     attr.Value = types.Int64{Value: policy.length}, types.Int64{Value: 1}
+		enabled         attr.Value = types.Bool{Value: true}
+		overrideSpecial attr.Value = types.String{Value: policy.special}
+	)
+
+	if policyName.IsUnknown() {
+		length, min = types.Int64{Unknown: true}, types.Int64{Unknown: true}
+		enabled = types.Bool{Unknown: true}
+		overrideSpecial = types.String{Unknown: true}
+	}
+
+	attributeValues := map[string]attr.Value{
+		"length":           length,
+		"override_special": overrideSpecial,
+	}
+
+	for _, class := range passwordPolicyClassAttributes {
+		attributeValues[class.enabled] = enabled
+		attributeValues[class.min] = min
+	}
+
+	for name, value := range attributeValues {
+		var configValue attr.Value
+
+		p := path.Root(name)
+
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, p, &configValue)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// The numeric attribute may also be configured by the deprecated number attribute.
+		if name == "numeric" {
+			var number attr.Value
+
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("number"), &number)...)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			if !number.IsNull() {
+				continue
+			}
+		}
+
+		if !configValue.IsNull() {
+			continue
+		}
+
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, p, value)...)
+
+		if name == "numeric" {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("number"), value)...)
+		}
+	}
+}
+
+// validatePasswordPolicy adds an error diagnostic for each planned attribute value which
+// violates the policy. Attribute values may only tighten the policy.
+func validatePasswordPolicy(ctx context.Context, policyName string, resp *resource.ModifyPlanResponse) {
+	policy := passwordPolicies[policyName]
+
+	var length types.Int64
+	var overrideSpecial types.String
+	var characterClasses []characterClassModel
+
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("length"), &length)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("override_special"), &overrideSpecial)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("character_class"), &characterClasses)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !length.IsUnknown() && (length.Value < policy.minLength || length.Value > policy.maxLength) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("length"),
+			"Password Policy Violation",
+			fmt.Sprintf("The %s policy requires a length between %d and %d, got: %d.",
+				policyName, policy.minLength, policy.maxLength, length.Value),
+		)
+	}
+
+	for _, class := range passwordPolicyClassAttributes {
+		var enabled types.Bool
+		var min types.Int64
+
+		enabledName, minName := class.enabled, class.min
+
+		diags := resp.Plan.GetAttribute(ctx, path.Root(enabledName), &enabled)
+		diags.Append(resp.Plan.GetAttribute(ctx, path.Root(minName), &min)...)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		if !enabled.IsUnknown() && !enabled.Value {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(enabledName),
+				"Password Policy Violation",
+				fmt.Sprintf("The %s policy requires %s to be true.", policyName, enabledName),
+			)
+		}
+
+		if !min.IsUnknown() && min.Value < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(minName),
+				"Password Policy Violation",
+				fmt.Sprintf("The %s policy requires %s to be at least 1, got: %d.", policyName, minName, min.Value),
+			)
+		}
+	}
+
+	// Only letters, numbers and the special characters of the policy may be used.
+	allowed := random.LowerChars + random.UpperChars + random.NumericChars + policy.special
+
+	if !overrideSpecial.IsUnknown() {
+		if invalid := disallowedChars(overrideSpecial.Value, policy.special); invalid != "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("override_special"),
+				"Password Policy Violation",
+				fmt.Sprintf("The %s policy only permits the special characters %q, but override_special contains: %q.",
+					policyName, policy.special, invalid),
+			)
+		}
+	}
+
+	for i, class := range characterClasses {
+		if class.Characters.IsUnknown() {
+			continue
+		}
+
+		if invalid := disallowedChars(class.Characters.Value, allowed); invalid != "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("character_class").AtListIndex(i).AtName("characters"),
+				"Password Policy Violation",
+				fmt.Sprintf("The %s policy only permits letters, numbers and the special characters %q, but the "+
+					"character_class contains: %q.",
+					policyName, policy.special, invalid),
+			)
+		}
+	}
+}
+
+// disallowedChars returns the characters in chars which are not in allowed.
+func disallowedChars(chars, allowed string) string {
+	var b strings.Builder
+
+	for _, c := range chars {
+		if !strings.ContainsRune(allowed, c) {
+			b.WriteRune(c)
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestPasswordPolicies(t *testing.T) {
+	t.Parallel()
+
+	for name, policy := range passwordPolicies {
+		if policy.length < policy.minLength || policy.length > policy.maxLength {
+			t.Errorf("%s: length %d is not between %d and %d", name, policy.length, policy.minLength, policy.maxLength)
+		}
+
+		if policy.minLength < 4 {
+			t.Errorf("%s: minimum length %d cannot include a character from each class", name, policy.minLength)
+		}
+
+		if policy.special == "" {
+			t.Errorf("%s: no special characters", name)
+		}
+
+		if strings.ContainsAny(policy.special, " \t\n") {
+			t.Errorf("%s: special characters contain whitespace: %q", name, policy.special)
+		}
+	}
+}
+
+func TestAccResourcePassword_Policy(t *testing.T) {
+	for _, name := range passwordPolicyNames() {
+		policy := passwordPolicies[name]
+
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`resource "random_password" "test" {
+							policy = %q
+						}`, name),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttrWith("random_password.test", "result", testCheckLen(int(policy.length))),
+							resource.TestCheckResourceAttr("random_password.test", "override_special", policy.special),
+							resource.TestMatchResourceAttr("random_password.test", "result", regexp.MustCompile(`[a-z]`)),
+							resource.TestMatchResourceAttr("random_password.test", "result", regexp.MustCompile(`[A-Z]`)),
+							resource.TestMatchResourceAttr("random_password.test", "result", regexp.MustCompile(`[0-9]`)),
+							resource.TestMatchResourceAttr("random_password.test", "result", regexp.MustCompile(`[^a-zA-Z0-9]`)),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccResourcePassword_Policy_Tighten(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							policy           = "oracle"
+							length           = 20
+							min_numeric      = 3
+							override_special = "_"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("random_password.test", "result", testCheckLen(20)),
+					resource.TestMatchResourceAttr("random_password.test", "result", regexp.MustCompile(`([0-9].*){3,}`)),
+					resource.TestMatchResourceAttr("random_password.test", "result", regexp.MustCompile(`^[a-zA-Z0-9_]+$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_Policy_Violation(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							policy = "oracle"
+							length = 40
+						}`,
+				ExpectError: regexp.MustCompile(`.*The oracle policy requires a length between 8 and 30, got: 40`),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							policy  = "oracle"
+							special = false
+						}`,
+				ExpectError: regexp.MustCompile(`.*The oracle policy requires special to be true`),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							policy           = "oracle"
+							override_special = "_!"
+						}`,
+				ExpectError: regexp.MustCompile(`.*The oracle policy only permits the special characters "_\$#", but\soverride_special contains: "!"`),
+			},
+		},
+	})
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// previousResultPrivateKey is the private state key under which the result and bcrypt_hash of a
+// random_password are planned, so that they are available to the plan which creates its replacement.
+const previousResultPrivateKey = "previous_result"
+
+// previousResult is the value stored in private state under previousResultPrivateKey.
+type previousResult struct {
+	Result     *string `json:"result"`
+	BcryptHash *string `json:"bcrypt_hash"`
+}
+
+// modifyPreviousResultPlan plans previous_result and previous_bcrypt_hash. When the resource is being
+// replaced, Terraform plans the replacement with a null prior state, but with the private state planned
+// for the resource being replaced, so the result and bcrypt_hash of every resource being updated or
+// replaced are planned into private state, from which they are read when planning the replacement. Both
+// are planned as null when store_result is false.
+func modifyPreviousResultPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var store types.Bool
+
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("store_result"), &store)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, bcryptHash := types.String{Null: true}, types.String{Null: true}
+
+	if req.State.Raw.IsNull() {
+		previous, diags := req.Private.GetKey(ctx, previousResultPrivateKey)
+		resp.Diagnostics.Append(diags...)
+
+		if previous != nil {
+			var err error
+
+			result, bcryptHash, err = parsePreviousResult(previous)
+			if err != nil {
+				resp.Diagnostics.AddError("Previous Result Error", err.Error())
+			}
+		}
+	} else {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("previous_result"), &result)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("previous_bcrypt_hash"), &bcryptHash)...)
+
+		setPreviousResultPrivate(ctx, req, resp)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !storeResult(store) {
+		result = types.String{Null: true}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("previous_result"), result)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("previous_bcrypt_hash"), bcryptHash)...)
+}
+
+// setPreviousResultPrivate plans the result and bcrypt_hash in state into private state.
+func setPreviousResultPrivate(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var result, bcryptHash types.String
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("result"), &result)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("bcrypt_hash"), &bcryptHash)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var previous previousResult
+
+	if !result.IsNull() {
+		previous.Result = &result.Value
+	}
+
+	if !bcryptHash.IsNull() {
+		previous.BcryptHash = &bcryptHash.Value
+	}
+
+	value, err := json.Marshal(previous)
+	if err != nil {
+		resp.Diagnostics.AddError("Previous Result Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, previousResultPrivateKey, value)...)
+}
+
+// parsePreviousResult returns the previous_result and previous_bcrypt_hash values stored in private state.
+func parsePreviousResult(value []byte) (types.String, types.String, error) {
+	var previous previousResult
+
+	if err := json.Unmarshal(value, &previous); err != nil {
+		return types.String{Null: true}, types.String{Null: true}, err
+	}
+
+	return previousString(previous.Result), previousString(previous.BcryptHash), nil
+}
+
+func previousString(value *string) types.String {
+	if value == nil {
+		return types.String{Null: true}
+	}
+
+	return types.String{Value: *value}
+}
+
+// clearPreviousResultPrivate removes the result and bcrypt_hash planned by modifyPreviousResultPlan from the
+// private state of a resource updated in place, so that they are not kept in state.
+func clearPreviousResultPrivate(ctx context.Context, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, previousResultPrivateKey, []byte("null"))...)
+}
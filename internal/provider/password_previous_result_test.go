@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestParsePreviousResult(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value              string
+		expectedResult     types.String
+		expectedBcryptHash types.String
+	}{
+		"result": {
+			value:              `{"result":"password","bcrypt_hash":"$2a$10$hash"}`,
+			expectedResult:     types.String{Value: "password"},
+			expectedBcryptHash: types.String{Value: "$2a$10$hash"},
+		},
+		"result-removed": {
+			value:              `{"result":null,"bcrypt_hash":"$2a$10$hash"}`,
+			expectedResult:     types.String{Null: true},
+			expectedBcryptHash: types.String{Value: "$2a$10$hash"},
+		},
+		"cleared": {
+			value:              `null`,
+			expectedResult:     types.String{Null: true},
+			expectedBcryptHash: types.String{Null: true},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, bcryptHash, err := parsePreviousResult([]byte(testCase.value))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !result.Equal(testCase.expectedResult) {
+				t.Errorf("expected result %s, got: %s", testCase.expectedResult, result)
+			}
+
+			if !bcryptHash.Equal(testCase.expectedBcryptHash) {
+				t.Errorf("expected bcrypt_hash %s, got: %s", testCase.expectedBcryptHash, bcryptHash)
+			}
+		})
+	}
+}
+
+func TestAccResourcePassword_PreviousResult(t *testing.T) {
+	var result1, result2, result3, previous, previousHash string
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length  = 12
+							keepers = {
+								rotation = "1"
+							}
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_password.test", "result", &result1),
+					resource.TestCheckNoResourceAttr("random_password.test", "previous_result"),
+					resource.TestCheckNoResourceAttr("random_password.test", "previous_bcrypt_hash"),
+				),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length  = 12
+							keepers = {
+								rotation = "2"
+							}
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_password.test", "result", &result2),
+					testExtractResourceAttr("random_password.test", "previous_result", &previous),
+					testCheckAttributeValuesDiffer(&result1, &result2),
+					testCheckAttributeValuesEqual(&result1, &previous),
+					testExtractResourceAttr("random_password.test", "previous_bcrypt_hash", &previousHash),
+					testBcryptHashValid(&previousHash, &result1),
+				),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length      = 12
+							bcrypt_cost = 5
+							keepers = {
+								rotation = "2"
+							}
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_password.test", "previous_result", &previous),
+					testCheckAttributeValuesEqual(&result1, &previous),
+				),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length      = 12
+							bcrypt_cost = 5
+							keepers = {
+								rotation = "3"
+							}
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_password.test", "result", &result3),
+					testExtractResourceAttr("random_password.test", "previous_result", &previous),
+					testCheckAttributeValuesDiffer(&result2, &result3),
+					testCheckAttributeValuesEqual(&result2, &previous),
+					testExtractResourceAttr("random_password.test", "previous_bcrypt_hash", &previousHash),
+					testBcryptHashValid(&previousHash, &result2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_PreviousResult_StoreResult(t *testing.T) {
+	var hash string
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length       = 12
+							store_result = false
+							keepers = {
+								rotation = "1"
+							}
+						}`,
+				Check: testExtractResourceAttr("random_password.test", "bcrypt_hash", &hash),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length       = 12
+							store_result = false
+							keepers = {
+								rotation = "2"
+							}
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("random_password.test", "previous_result"),
+					resource.TestCheckResourceAttrPtr("random_password.test", "previous_bcrypt_hash", &hash),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// passwordRegeneratedAttributes are the attributes of random_password which are regenerated from the
+// result in place, and so require replacement when the result is no longer stored in state.
+var passwordRegeneratedAttributes = []string{"bcrypt_hash", "hashes", "htpasswd_line"}
+
+// storeResult returns whether the result is stored in state, which is the default.
+func storeResult(store types.Bool) bool {
+	return store.IsNull() || store.IsUnknown() || store.Value
+}
+
+// modifyStoreResultPlan plans the result as null when store_result is false, so that the result is never
+// written to state. Once the result has been removed from state, it cannot be restored or used to regenerate
+// bcrypt_hash, hashes or htpasswd_line, so setting store_result to true, or changing the attributes from which
+// they are generated, requires replacement. It must be called after the attribute plans have otherwise been
+// modified.
+func modifyStoreResultPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var store types.Bool
+
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("store_result"), &store)...)
+
+	if resp.Diagnostics.HasError() || store.IsUnknown() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		if !storeResult(store) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("result"), types.String{Null: true})...)
+		}
+
+		return
+	}
+
+	var stateResult types.String
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("result"), &stateResult)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !stateResult.IsNull() {
+		if !storeResult(store) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("result"), types.String{Null: true})...)
+		}
+
+		return
+	}
+
+	if storeResult(store) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("store_result"))
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("result"), types.String{Unknown: true})...)
+
+		return
+	}
+
+	for _, name := range passwordRegeneratedAttributes {
+		var value attr.Value
+
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root(name), &value)...)
+
+		if value != nil && value.IsUnknown() {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root(name))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("result"), types.String{Null: true})...)
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourcePassword_StoreResult(t *testing.T) {
+	var result, hash1, hash2 string
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length       = 12
+							store_result = false
+
+							hash_options {
+								algorithms = ["argon2id"]
+							}
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("random_password.test", "result"),
+					resource.TestCheckNoResourceAttr("random_password.test", "previous_result"),
+					resource.TestCheckResourceAttr("random_password.test", "byte_length", "12"),
+					resource.TestCheckResourceAttrSet("random_password.test", "hashes.argon2id"),
+					testExtractResourceAttr("random_password.test", "bcrypt_hash", &hash1),
+				),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length       = 12
+							store_result = false
+
+							hash_options {
+								algorithms = ["argon2id"]
+							}
+						}`,
+				PlanOnly: true,
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length       = 12
+							store_result = false
+							bcrypt_cost  = 5
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("random_password.test", "result"),
+					testExtractResourceAttr("random_password.test", "bcrypt_hash", &hash2),
+					testCheckAttributeValuesDiffer(&hash1, &hash2),
+					resource.TestMatchResourceAttr("random_password.test", "bcrypt_hash", regexp.MustCompile(`^\$2a\$05\$`)),
+				),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length       = 12
+							store_result = true
+							bcrypt_cost  = 5
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("random_password.test", "result", testCheckLen(12)),
+					testExtractResourceAttr("random_password.test", "result", &result),
+					testExtractResourceAttr("random_password.test", "bcrypt_hash", &hash1),
+					testCheckAttributeValuesDiffer(&hash1, &hash2),
+					testBcryptHashValid(&hash1, &result),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_StoreResult_Update(t *testing.T) {
+	var result, hash string
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+				Check: testExtractResourceAttr("random_password.test", "result", &result),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length       = 12
+							store_result = false
+							bcrypt_cost  = 5
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("random_password.test", "result"),
+					resource.TestMatchResourceAttr("random_password.test", "bcrypt_hash", regexp.MustCompile(`^\$2a\$05\$`)),
+					testExtractResourceAttr("random_password.test", "bcrypt_hash", &hash),
+					testBcryptHashValid(&hash, &result),
+				),
+			},
+		},
+	})
+}
@@ -2,14 +2,28 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/diagnostics"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
 )
 
+// reproducibleSeedEnvVar is the environment variable which can be used instead of the
+// reproducible_seed provider attribute.
+const reproducibleSeedEnvVar = "RANDOM_REPRODUCIBLE_SEED"
+
 func New() provider.Provider {
 	return &randomProvider{}
 }
@@ -23,16 +37,113 @@ func (p *randomProvider) Metadata(_ context.Context, _ provider.MetadataRequest,
 }
 
 func (p *randomProvider) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
-	return tfsdk.Schema{}, nil
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"reproducible_seed": {
+				Description: "Enables reproducible mode, in which every resource generates its values " +
+					"deterministically from this seed, the resource type and the resource configuration, instead " +
+					"of using a random number generator. Each resource must set `keepers` which identify it. " +
+					"Can also be set with the `" + reproducibleSeedEnvVar +
+					"` environment variable. Generated values are predictable to anyone who knows the seed, " +
+					"so this must only be used for testing.",
+				Type:      types.StringType,
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+		Blocks: map[string]tfsdk.Block{
+			"entropy_source": {
+				Description: "The source of randomness from which all resources generate their values. " +
+					"Defaults to the cryptographically secure random number generator of the operating system. " +
+					"Cannot be used with `reproducible_seed`.",
+				NestingMode: tfsdk.BlockNestingModeSingle,
+				Attributes: map[string]tfsdk.Attribute{
+					"file": {
+						Description: "Path of a file, device or named pipe (FIFO) from which random bytes are " +
+							"read. The file is read sequentially and must supply enough bytes for every " +
+							"resource created during a Terraform operation.",
+						// This attribute is validated in Configure, as the framework validates
+						// required attributes of single nested blocks even when the block is absent.
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+			},
+			"defaults": {
+				Description: "Default values for resource attributes. A default is only used when the " +
+					"corresponding attribute is not set in the resource configuration.",
+				NestingMode: tfsdk.BlockNestingModeSingle,
+				Blocks: map[string]tfsdk.Block{
+					"password": stringDefaultsBlock("random_password"),
+					"string":   stringDefaultsBlock("random_string"),
+				},
+			},
+		},
+	}, nil
 }
 
-func (p *randomProvider) Configure(context.Context, provider.ConfigureRequest, *provider.ConfigureResponse) {
+func (p *randomProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var config providerModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := &providerData{
+		reproducibleSeed: os.Getenv(reproducibleSeedEnvVar),
+	}
+
+	if !config.ReproducibleSeed.IsNull() {
+		data.reproducibleSeed = config.ReproducibleSeed.Value
+	}
+
+	if config.EntropySource != nil {
+		if data.reproducibleSeed != "" {
+			resp.Diagnostics.AddError(
+				"Conflicting Randomness Configuration",
+				"The entropy_source block cannot be used in reproducible mode, which is enabled by the "+
+					"reproducible_seed attribute or "+reproducibleSeedEnvVar+" environment variable.",
+			)
+			return
+		}
+
+		if config.EntropySource.File.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("entropy_source").AtName("file"),
+				"Missing Entropy Source File",
+				"The file attribute must be set in the entropy_source block.",
+			)
+			return
+		}
+
+		data.entropySource = random.NewFileEntropySource(config.EntropySource.File.Value)
+	}
+
+	if data.reproducibleSeed != "" {
+		resp.Diagnostics.AddWarning(
+			"Reproducible Mode Enabled",
+			"The provider reproducible_seed attribute or "+reproducibleSeedEnvVar+" environment variable is set, "+
+				"so all resources generate values deterministically rather than randomly. "+
+				"Generated values, including passwords, are predictable to anyone who knows the seed.\n\n"+
+				"Reproducible mode must only be used for testing and must never be used for real infrastructure.",
+		)
+	}
+
+	if config.Defaults != nil {
+		data.passwordDefaults = config.Defaults.Password
+		data.stringDefaults = config.Defaults.String
+	}
+
+	resp.ResourceData = data
 }
 
 func (p *randomProvider) Resources(context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewBytesResource,
 		NewIdResource,
 		NewIntegerResource,
+		NewPassphraseResource,
 		NewPasswordResource,
 		NewPetResource,
 		NewShuffleResource,
@@ -44,3 +155,264 @@ func (p *randomProvider) Resources(context.Context) []func() resource.Resource {
 func (p *randomProvider) DataSources(context.Context) []func() datasource.DataSource {
 	return nil
 }
+
+// providerData is passed to resources via provider.ConfigureResponse.ResourceData.
+type providerData struct {
+	passwordDefaults *stringDefaultsModel
+	stringDefaults   *stringDefaultsModel
+	reproducibleSeed string
+	entropySource    random.EntropySource
+
+	// reproducibleConfigs are the resource types and configurations from which values have been
+	// generated in reproducible mode, so that resources which would generate identical values are
+	// detected.
+	reproducibleConfigsMu sync.Mutex
+	reproducibleConfigs   map[string]bool
+}
+
+// randSource returns the EntropySource from which a resource of the given type should
+// generate its values, where config is the configuration of the resource being created.
+//
+// When reproducible mode is enabled, a deterministic source derived from the provider seed,
+// the resource type and every attribute of the configuration is returned. Terraform does not
+// supply the resource address to providers, so the keepers of the resource must identify it.
+// An error diagnostic is returned if keepers are not set, or if a resource of the same type with
+// an identical configuration has already generated values, rather than generating identical values.
+func (d *providerData) randSource(ctx context.Context, typeName string, config tfsdk.Config) (random.EntropySource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if d == nil || d.reproducibleSeed == "" {
+		return d.entropySourceFor(typeName), diags
+	}
+
+	var keepers types.Map
+
+	diags.Append(config.GetAttribute(ctx, path.Root("keepers"), &keepers)...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if len(keepers.Elems) == 0 {
+		diags.AddAttributeError(
+			path.Root("keepers"),
+			"Missing Reproducible Mode Keepers",
+			"In reproducible mode, values are derived from the provider seed, the resource type and the "+
+				"resource configuration, as Terraform does not supply the resource address to providers. "+
+				"Set keepers to values which identify this resource, such as keepers = { name = \"primary\" }, "+
+				"so that it does not generate the same values as other "+typeName+" resources.",
+		)
+
+		return nil, diags
+	}
+
+	configuration := config.Raw.String()
+
+	d.reproducibleConfigsMu.Lock()
+	defer d.reproducibleConfigsMu.Unlock()
+
+	key := typeName + "\x00" + configuration
+
+	if d.reproducibleConfigs[key] {
+		diags.AddAttributeError(
+			path.Root("keepers"),
+			"Duplicate Reproducible Mode Configuration",
+			"In reproducible mode, another "+typeName+" resource with an identical configuration has already "+
+				"generated values during this Terraform operation, so this resource would generate the same "+
+				"values. Set keepers to values which distinguish the resources, such as count.index or each.key.",
+		)
+
+		return nil, diags
+	}
+
+	if d.reproducibleConfigs == nil {
+		d.reproducibleConfigs = make(map[string]bool)
+	}
+
+	d.reproducibleConfigs[key] = true
+
+	return d.entropySourceFor(typeName, configuration), diags
+}
+
+// saltSource returns the EntropySource from which the salts of the hashes of value, a value
+// generated by a resource of the given type, should be generated. Unlike randSource, it does
+// not require keepers in reproducible mode, as the deterministic source is derived from value.
+func (d *providerData) saltSource(typeName string, value string) random.EntropySource {
+	return d.entropySourceFor(typeName, "salt", value)
+}
+
+// entropySourceFor returns the configured entropy source, or, when reproducible mode is enabled,
+// a deterministic source derived from the provider seed and context.
+func (d *providerData) entropySourceFor(context ...string) random.EntropySource {
+	if d == nil {
+		return random.NewCryptoEntropySource()
+	}
+
+	if d.reproducibleSeed != "" {
+		return random.NewReproducibleEntropySource(d.reproducibleSeed, context...)
+	}
+
+	if d.entropySource == nil {
+		return random.NewCryptoEntropySource()
+	}
+
+	return d.entropySource
+}
+
+// generator returns a random.Generator using the given seed algorithm for a resource of the given
+// type which has the given seed configured. If no seed is configured, the generator reads from
+// randSource.
+func (d *providerData) generator(ctx context.Context, typeName string, algorithm string, seed string, config tfsdk.Config) (random.Generator, diag.Diagnostics) {
+	if seed != "" {
+		generator, err := random.NewSeededGenerator(algorithm, seed)
+		if err != nil {
+			return nil, randError(err)
+		}
+
+		return generator, nil
+	}
+
+	source, diags := d.randSource(ctx, typeName, config)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	generator, err := random.NewGenerator(algorithm, source)
+	if err != nil {
+		diags.Append(randError(err)...)
+	}
+
+	return generator, diags
+}
+
+// randError returns the diagnostics for an error returned when reading from an EntropySource.
+func randError(err error) diag.Diagnostics {
+	if errors.Is(err, random.ErrInsufficientEntropy) {
+		return diagnostics.RandomnessGenerationError(err.Error())
+	}
+
+	return diagnostics.RandomReadError(err.Error())
+}
+
+// configureProviderData returns the providerData supplied to a resource Configure method, which
+// is nil when the provider has not yet been configured.
+func configureProviderData(req resource.ConfigureRequest, resp *resource.ConfigureResponse) *providerData {
+	if req.ProviderData == nil {
+		return nil
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return nil
+	}
+
+	return data
+}
+
+func stringDefaultsBlock(resourceType string) tfsdk.Block {
+	return tfsdk.Block{
+		Description: "Default values for `" + resourceType + "` resources.",
+		NestingMode: tfsdk.BlockNestingModeSingle,
+		Attributes: map[string]tfsdk.Attribute{
+			"length": {
+				Description: "Default for the `length` attribute.",
+				Type:        types.Int64Type,
+				Optional:    true,
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(1),
+				},
+			},
+			"special": {
+				Description: "Default for the `special` attribute.",
+				Type:        types.BoolType,
+				Optional:    true,
+			},
+			"upper": {
+				Description: "Default for the `upper` attribute.",
+				Type:        types.BoolType,
+				Optional:    true,
+			},
+			"lower": {
+				Description: "Default for the `lower` attribute.",
+				Type:        types.BoolType,
+				Optional:    true,
+			},
+			"numeric": {
+				Description: "Default for the `numeric` attribute. Also used for the deprecated `number` attribute.",
+				Type:        types.BoolType,
+				Optional:    true,
+			},
+			"min_numeric": {
+				Description: "Default for the `min_numeric` attribute.",
+				Type:        types.Int64Type,
+				Optional:    true,
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(0),
+				},
+			},
+			"min_upper": {
+				Description: "Default for the `min_upper` attribute.",
+				Type:        types.Int64Type,
+				Optional:    true,
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(0),
+				},
+			},
+			"min_lower": {
+				Description: "Default for the `min_lower` attribute.",
+				Type:        types.Int64Type,
+				Optional:    true,
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(0),
+				},
+			},
+			"min_special": {
+				Description: "Default for the `min_special` attribute.",
+				Type:        types.Int64Type,
+				Optional:    true,
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(0),
+				},
+			},
+			"override_special": {
+				Description: "Default for the `override_special` attribute.",
+				Type:        types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+type providerModel struct {
+	ReproducibleSeed types.String        `tfsdk:"reproducible_seed"`
+	EntropySource    *entropySourceModel `tfsdk:"entropy_source"`
+	Defaults         *defaultsModel      `tfsdk:"defaults"`
+}
+
+type entropySourceModel struct {
+	File types.String `tfsdk:"file"`
+}
+
+type defaultsModel struct {
+	Password *stringDefaultsModel `tfsdk:"password"`
+	String   *stringDefaultsModel `tfsdk:"string"`
+}
+
+type stringDefaultsModel struct {
+	Length          types.Int64  `tfsdk:"length"`
+	Special         types.Bool   `tfsdk:"special"`
+	Upper           types.Bool   `tfsdk:"upper"`
+	Lower           types.Bool   `tfsdk:"lower"`
+	Numeric         types.Bool   `tfsdk:"numeric"`
+	MinNumeric      types.Int64  `tfsdk:"min_numeric"`
+	MinUpper        types.Int64  `tfsdk:"min_upper"`
+	MinLower        types.Int64  `tfsdk:"min_lower"`
+	MinSpecial      types.Int64  `tfsdk:"min_special"`
+	OverrideSpecial types.String `tfsdk:"override_special"`
+}
@@ -0,0 +1,382 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/planmodifiers"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+var (
+	_ resource.Resource                = (*bytesResource)(nil)
+	_ resource.ResourceWithConfigure   = (*bytesResource)(nil)
+	_ resource.ResourceWithImportState = (*bytesResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*bytesResource)(nil)
+)
+
+// bytesPurpose is a preset of the random_bytes length for key material of a particular
+// algorithm.
+type bytesPurpose struct {
+	// length is the default length of the bytes.
+	length int64
+
+	// minLength and maxLength are the bounds of the length accepted for the purpose.
+	minLength int64
+	maxLength int64
+}
+
+// bytesPurposes are the presets which may be selected by the random_bytes purpose attribute.
+var bytesPurposes = map[string]bytesPurpose{
+	// AES-256 keys are exactly 32 bytes.
+	"aes256": {
+		length:    32,
+		minLength: 32,
+		maxLength: 32,
+	},
+	// Fernet keys are 32 bytes, a 16 byte signing key followed by a 16 byte encryption key,
+	// and are encoded as the base64url attribute.
+	"fernet": {
+		length:    32,
+		minLength: 32,
+		maxLength: 32,
+	},
+	// HMAC-SHA256 keys shorter than the 32 byte output weaken the MAC, and keys longer than
+	// the 64 byte block size are hashed to 32 bytes before use (RFC 2104).
+	"hmac-sha256": {
+		length:    32,
+		minLength: 32,
+		maxLength: 64,
+	},
+}
+
+// lengths describes the lengths accepted for the purpose.
+func (p bytesPurpose) lengths() string {
+	if p.minLength == p.maxLength {
+		return fmt.Sprintf("%d bytes", p.minLength)
+	}
+
+	return fmt.Sprintf("between %d and %d bytes", p.minLength, p.maxLength)
+}
+
+// bytesPurposeNames returns the names of all random_bytes purposes, sorted alphabetically.
+func bytesPurposeNames() []string {
+	names := make([]string, 0, len(bytesPurposes))
+
+	for name := range bytesPurposes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func NewBytesResource() resource.Resource {
+	return &bytesResource{}
+}
+
+type bytesResource struct {
+	providerData *providerData
+}
+
+func (r *bytesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bytes"
+}
+
+func (r *bytesResource) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "The resource `random_bytes` generates random bytes for use as key material, such as " +
+			"AES, HMAC and Fernet keys or cookie secrets. Unlike [random_id](id.html), each encoding of the " +
+			"bytes is sensitive.\n" +
+			"\n" +
+			"This resource *does* use a cryptographic random number generator.",
+		Attributes: map[string]tfsdk.Attribute{
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					planmodifiers.RequiresReplaceIfValuesNotNull(),
+				},
+			},
+
+			"length": {
+				Description: "The number of random bytes to generate. Required unless `purpose` is set, in " +
+					"which case it defaults to the key length of the purpose.",
+				Type:     types.Int64Type,
+				Optional: true,
+				Computed: true,
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(1),
+				},
+			},
+
+			"purpose": {
+				Description: "A preset of `length` for the key material of an algorithm. The only allowed " +
+					"values are `aes256` (32 bytes), `fernet` (32 bytes, used as `base64url`) and `hmac-sha256` " +
+					"(32 bytes by default, up to its 64 byte block size).",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					planmodifiers.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					stringvalidator.OneOf(bytesPurposeNames()...),
+				},
+			},
+
+			"base64": {
+				Description: "The generated bytes, encoded as standard base64 with padding.",
+				Type:        types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					resource.UseStateForUnknown(),
+				},
+			},
+
+			"base64url": {
+				Description: "The generated bytes, encoded as URL-safe base64 with padding, which is the " +
+					"format of a Fernet key.",
+				Type:      types.StringType,
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					resource.UseStateForUnknown(),
+				},
+			},
+
+			"hex": {
+				Description: "The generated bytes, encoded as lowercase hexadecimal.",
+				Type:        types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					resource.UseStateForUnknown(),
+				},
+			},
+
+			"id": {
+				Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
+				Computed:    true,
+				Type:        types.StringType,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					resource.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (r *bytesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.providerData = configureProviderData(req, resp)
+}
+
+// ModifyPlan sets the planned length to the length of the purpose when it is not configured, and
+// requires replacement when the planned length differs from the length of the existing bytes.
+func (r *bytesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Plan is null when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var purpose types.String
+	var length types.Int64
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("purpose"), &purpose)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("length"), &length)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case purpose.IsNull() && length.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("length"),
+			"Missing Length",
+			"The length attribute must be set unless the purpose attribute is set.",
+		)
+		return
+	case !purpose.IsNull() && !purpose.IsUnknown():
+		p := bytesPurposes[purpose.Value]
+
+		if length.IsNull() {
+			length = types.Int64{Value: p.length}
+
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("length"), length)...)
+		}
+
+		if !length.IsUnknown() && (length.Value < p.minLength || length.Value > p.maxLength) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("length"),
+				"Invalid Length for Purpose",
+				fmt.Sprintf("The %s purpose requires %s, got: %d.", purpose.Value, p.lengths(), length.Value),
+			)
+			return
+		}
+	}
+
+	if resp.Diagnostics.HasError() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plannedLength, stateLength types.Int64
+
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("length"), &plannedLength)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("length"), &stateLength)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plannedLength.Equal(stateLength) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("length"))
+	}
+}
+
+func (r *bytesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan bytesModelV0
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bytes := make([]byte, plan.Length.Value)
+
+	source, diags := r.providerData.randSource(ctx, "random_bytes", req.Config)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := random.ReadEntropy(source, bytes)
+	if err != nil {
+		resp.Diagnostics.Append(randError(err)...)
+		return
+	}
+
+	plan.setBytes(bytes)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *bytesResource) Read(context.Context, resource.ReadRequest, *resource.ReadResponse) {
+}
+
+// Update ensures the plan value is copied to the state to complete the update.
+func (r *bytesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model bytesModelV0
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *bytesResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+// ImportState sets the attributes of the resource from the import ID, which is the base64 of the bytes,
+// optionally preceded by the purpose and a colon. See parseBytesImportID for the format of the import ID.
+func (r *bytesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	purpose, bytes, err := parseBytesImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Import Random Bytes Error",
+			"Invalid import usage: expecting {base64} or {purpose}:{base64}, where purpose is one of "+
+				strings.Join(bytesPurposeNames(), ", ")+".\n\n"+
+				fmt.Sprintf("Original Error: %s", err),
+		)
+		return
+	}
+
+	state := bytesModelV0{
+		Keepers: types.Map{ElemType: types.StringType, Null: true},
+		Length:  types.Int64{Value: int64(len(bytes))},
+		Purpose: types.String{Null: purpose == "", Value: purpose},
+	}
+
+	state.setBytes(bytes)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// parseBytesImportID returns the purpose and bytes of a random_bytes import ID, which is the base64
+// attribute, optionally preceded by the purpose and a colon. The padding of the base64 may be omitted.
+// As a colon is not a base64 character, the purpose is unambiguous. An error is returned if the number
+// of bytes is not accepted for the purpose.
+func parseBytesImportID(importID string) (purpose string, bytes []byte, err error) {
+	value := importID
+
+	if i := strings.Index(importID, ":"); i >= 0 {
+		purpose, value = importID[:i], importID[i+1:]
+
+		if _, ok := bytesPurposes[purpose]; !ok {
+			return "", nil, fmt.Errorf("unknown purpose %q", purpose)
+		}
+	}
+
+	bytes, err = base64.RawStdEncoding.DecodeString(strings.TrimRight(value, "="))
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(bytes) == 0 {
+		return "", nil, fmt.Errorf("at least 1 byte is required")
+	}
+
+	if purpose != "" {
+		p := bytesPurposes[purpose]
+
+		if int64(len(bytes)) < p.minLength || int64(len(bytes)) > p.maxLength {
+			return "", nil, fmt.Errorf("the %s purpose requires %s, got: %d", purpose, p.lengths(), len(bytes))
+		}
+	}
+
+	return purpose, bytes, nil
+}
+
+type bytesModelV0 struct {
+	ID        types.String `tfsdk:"id"`
+	Keepers   types.Map    `tfsdk:"keepers"`
+	Length    types.Int64  `tfsdk:"length"`
+	Purpose   types.String `tfsdk:"purpose"`
+	Base64    types.String `tfsdk:"base64"`
+	Base64URL types.String `tfsdk:"base64url"`
+	Hex       types.String `tfsdk:"hex"`
+}
+
+// setBytes sets the id and each encoding of the bytes.
+func (m *bytesModelV0) setBytes(bytes []byte) {
+	m.ID = types.String{Value: "none"}
+	m.Base64 = types.String{Value: base64.StdEncoding.EncodeToString(bytes)}
+	m.Base64URL = types.String{Value: base64.URLEncoding.EncodeToString(bytes)}
+	m.Hex = types.String{Value: hex.EncodeToString(bytes)}
+}
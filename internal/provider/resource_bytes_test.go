@@ -0,0 +1,299 @@
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceBytes(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_bytes" "basic" {
+							length = 16
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_bytes.basic", "id", "none"),
+					resource.TestMatchResourceAttr("random_bytes.basic", "base64", regexp.MustCompile(`^[A-Za-z0-9+/]{22}==$`)),
+					resource.TestMatchResourceAttr("random_bytes.basic", "base64url", regexp.MustCompile(`^[A-Za-z0-9_-]{22}==$`)),
+					resource.TestMatchResourceAttr("random_bytes.basic", "hex", regexp.MustCompile(`^[0-9a-f]{32}$`)),
+					resource.TestCheckNoResourceAttr("random_bytes.basic", "purpose"),
+				),
+			},
+			{
+				ResourceName:      "random_bytes.basic",
+				ImportState:       true,
+				ImportStateIdFunc: testBytesImportIDFunc("random_bytes.basic", ""),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceBytes_Purpose(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_bytes" "test" {
+							purpose = "fernet"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_bytes.test", "length", "32"),
+					resource.TestMatchResourceAttr("random_bytes.test", "base64url", regexp.MustCompile(`^[A-Za-z0-9_-]{43}=$`)),
+					resource.TestMatchResourceAttr("random_bytes.test", "hex", regexp.MustCompile(`^[0-9a-f]{64}$`)),
+				),
+			},
+			{
+				ResourceName:      "random_bytes.test",
+				ImportState:       true,
+				ImportStateIdFunc: testBytesImportIDFunc("random_bytes.test", "fernet:"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceBytes_PurposeLength(t *testing.T) {
+	var result1, result2 string
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_bytes" "test" {
+							purpose = "hmac-sha256"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_bytes.test", "hex", &result1),
+					resource.TestCheckResourceAttr("random_bytes.test", "length", "32"),
+				),
+			},
+			{
+				Config: `resource "random_bytes" "test" {
+							purpose = "hmac-sha256"
+							length  = 32
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_bytes.test", "hex", &result2),
+					testCheckAttributeValuesEqual(&result1, &result2),
+				),
+			},
+			{
+				Config: `resource "random_bytes" "test" {
+							purpose = "hmac-sha256"
+							length  = 64
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_bytes.test", "hex", &result2),
+					testCheckAttributeValuesDiffer(&result1, &result2),
+					resource.TestMatchResourceAttr("random_bytes.test", "hex", regexp.MustCompile(`^[0-9a-f]{128}$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceBytes_Keepers(t *testing.T) {
+	var result1, result2 string
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_bytes" "test" {
+							purpose = "aes256"
+							keepers = {
+								key = "1"
+							}
+						}`,
+				Check: testExtractResourceAttr("random_bytes.test", "base64", &result1),
+			},
+			{
+				Config: `resource "random_bytes" "test" {
+							purpose = "aes256"
+							keepers = {
+								key = "2"
+							}
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_bytes.test", "base64", &result2),
+					testCheckAttributeValuesDiffer(&result1, &result2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceBytes_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      `resource "random_bytes" "test" {}`,
+				ExpectError: regexp.MustCompile(`The length attribute must be set unless the purpose attribute is set`),
+			},
+			{
+				Config: `resource "random_bytes" "test" {
+							length = 0
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute length value must be at least 1`),
+			},
+			{
+				Config: `resource "random_bytes" "test" {
+							purpose = "aes128"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute purpose value must be one of`),
+			},
+			{
+				Config: `resource "random_bytes" "test" {
+							purpose = "aes256"
+							length  = 16
+						}`,
+				ExpectError: regexp.MustCompile(`The aes256 purpose requires 32 bytes, got: 16`),
+			},
+		},
+	})
+}
+
+func TestAccResourceBytes_Import(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_bytes" "test" {
+							purpose = "aes256"
+						}`,
+				ResourceName:       "random_bytes.test",
+				ImportState:        true,
+				ImportStateId:      "aes256:AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8",
+				ImportStatePersist: true,
+			},
+			{
+				Config: `resource "random_bytes" "test" {
+							purpose = "aes256"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_bytes.test", "length", "32"),
+					resource.TestCheckResourceAttr("random_bytes.test", "base64", "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8="),
+					resource.TestCheckResourceAttr("random_bytes.test", "hex", "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceBytes_Import_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_bytes" "test" {
+							length = 4
+						}`,
+				ResourceName:  "random_bytes.test",
+				ImportState:   true,
+				ImportStateId: "p-9hUg",
+				ExpectError:   regexp.MustCompile(`Import Random Bytes Error`),
+			},
+			{
+				Config: `resource "random_bytes" "test" {
+							purpose = "aes256"
+						}`,
+				ResourceName:  "random_bytes.test",
+				ImportState:   true,
+				ImportStateId: "aes256:p+9hUg==",
+				ExpectError:   regexp.MustCompile(`the aes256 purpose requires 32 bytes, got: 4`),
+			},
+		},
+	})
+}
+
+func TestParseBytesImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		importID        string
+		expectedPurpose string
+		expectedBytes   []byte
+		expectedError   bool
+	}{
+		"base64": {
+			importID:      "p+9hUg==",
+			expectedBytes: []byte{0xa7, 0xef, 0x61, 0x52},
+		},
+		"base64-unpadded": {
+			importID:      "p+9hUg",
+			expectedBytes: []byte{0xa7, 0xef, 0x61, 0x52},
+		},
+		"purpose": {
+			importID:        "hmac-sha256:" + "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=",
+			expectedPurpose: "hmac-sha256",
+			expectedBytes:   make([]byte, 32),
+		},
+		"base64url": {
+			importID:      "p-9hUg",
+			expectedError: true,
+		},
+		"empty": {
+			importID:      "",
+			expectedError: true,
+		},
+		"unknown-purpose": {
+			importID:      "aes128:p+9hUg==",
+			expectedError: true,
+		},
+		"purpose-length": {
+			importID:      "fernet:p+9hUg==",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			purpose, got, err := parseBytesImportID(testCase.importID)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Errorf("expected error, got: %x", got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if purpose != testCase.expectedPurpose {
+				t.Errorf("expected purpose %q, got: %q", testCase.expectedPurpose, purpose)
+			}
+
+			if !bytes.Equal(got, testCase.expectedBytes) {
+				t.Errorf("expected bytes %x, got: %x", testCase.expectedBytes, got)
+			}
+		})
+	}
+}
+
+// testBytesImportIDFunc returns the import ID of a random_bytes, its base64 preceded by prefix.
+func testBytesImportIDFunc(resourceName, prefix string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		return prefix + rs.Primary.Attributes["base64"], nil
+	}
+}
@@ -2,11 +2,11 @@ package provider
 
 import (
 	"context"
-	"crypto/rand"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
 	"math/big"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -14,12 +14,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"github.com/terraform-providers/terraform-provider-random/internal/diagnostics"
 	"github.com/terraform-providers/terraform-provider-random/internal/planmodifiers"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
 )
 
 var (
 	_ resource.Resource                = (*idResource)(nil)
+	_ resource.ResourceWithConfigure   = (*idResource)(nil)
 	_ resource.ResourceWithImportState = (*idResource)(nil)
 )
 
@@ -27,7 +28,9 @@ func NewIdResource() resource.Resource {
 	return &idResource{}
 }
 
-type idResource struct{}
+type idResource struct {
+	providerData *providerData
+}
 
 func (r *idResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_id"
@@ -125,6 +128,10 @@ exist concurrently.
 	}, nil
 }
 
+func (r *idResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.providerData = configureProviderData(req, resp)
+}
+
 func (r *idResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan idModelV0
 
@@ -137,13 +144,17 @@ func (r *idResource) Create(ctx context.Context, req resource.CreateRequest, res
 	byteLength := plan.ByteLength.Value
 	bytes := make([]byte, byteLength)
 
-	n, err := rand.Reader.Read(bytes)
-	if int64(n) != byteLength {
-		resp.Diagnostics.Append(diagnostics.RandomnessGenerationError(err.Error())...)
+	source, diags := r.providerData.randSource(ctx, "random_id", req.Config)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	err := random.ReadEntropy(source, bytes)
 	if err != nil {
-		resp.Diagnostics.Append(diagnostics.RandomReadError(err.Error())...)
+		resp.Diagnostics.Append(randError(err)...)
 		return
 	}
 
@@ -196,27 +207,22 @@ func (r *idResource) Update(ctx context.Context, req resource.UpdateRequest, res
 func (r *idResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
 }
 
+// ImportState sets the attributes of the resource from the import ID, which is the b64_url of the id, or,
+// with an encoding hint, its hex, dec or b64_std. In each case, it is optionally preceded by the prefix
+// and a comma. See parseIDImportID for the format of the import ID.
 func (r *idResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id := req.ID
-	var prefix string
-
-	sep := strings.LastIndex(id, ",")
-	if sep != -1 {
-		prefix = id[:sep]
-		id = id[sep+1:]
-	}
-
-	bytes, err := base64.RawURLEncoding.DecodeString(id)
+	prefix, bytes, err := parseIDImportID(req.ID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Import Random ID Error",
-			"While attempting to import a random id there was a decoding error.\n\n+"+
-				diagnostics.RetryMsg+
+			"While attempting to import a random id there was a decoding error. Expecting [prefix,]{b64_url}, "+
+				"or {hint}:[prefix,]{value}, where hint is hex, dec, dec:{byte_length}, b64std or b64url.\n\n"+
 				fmt.Sprintf("Original Error: %s", err),
 		)
 		return
 	}
 
+	id := base64.RawURLEncoding.EncodeToString(bytes)
 	b64Std := base64.StdEncoding.EncodeToString(bytes)
 	hexStr := hex.EncodeToString(bytes)
 
@@ -247,6 +253,112 @@ func (r *idResource) ImportState(ctx context.Context, req resource.ImportStateRe
 	}
 }
 
+// The encoding hints of the random_id import ID, which precede the rest of the import ID and a colon.
+const (
+	idImportHex    = "hex"
+	idImportDec    = "dec"
+	idImportB64Std = "b64std"
+	idImportB64URL = "b64url"
+)
+
+// maxIDImportByteLength is the greatest byte length which may be given with the dec hint, so that
+// the bytes padded to the byte length are never allocated from an unreasonable import ID.
+const maxIDImportByteLength = 1 << 20
+
+// parseIDImportID returns the prefix and bytes of a random_id import ID, which is either
+// [prefix,]b64_url, or {hint}:[prefix,]{value}, where hint is the encoding of value:
+//
+//   - hex: the hex attribute.
+//   - dec: the dec attribute. As the leading zero bytes of the id are lost when it is encoded in
+//     decimal, the byte length may be given after the hint, as in dec:8:[prefix,]{value}, in which
+//     case the bytes are padded with leading zeros to the byte length. The byte length must be
+//     between 1 and maxIDImportByteLength.
+//   - b64std: the b64_std attribute.
+//   - b64url: the b64_url attribute, as when no hint is given.
+func parseIDImportID(importID string) (string, []byte, error) {
+	hint := idImportB64URL
+
+	if i := strings.Index(importID, ":"); i != -1 {
+		switch importID[:i] {
+		case idImportHex, idImportDec, idImportB64Std, idImportB64URL:
+			hint = importID[:i]
+			importID = importID[i+1:]
+		}
+	}
+
+	var byteLength int64
+
+	if hint == idImportDec {
+		if i := strings.Index(importID, ":"); i != -1 {
+			if n, err := strconv.ParseInt(importID[:i], 10, 64); err == nil {
+				if n < 1 || n > maxIDImportByteLength {
+					return "", nil, fmt.Errorf("the byte length must be between 1 and %d, got: %d", maxIDImportByteLength, n)
+				}
+
+				byteLength = n
+				importID = importID[i+1:]
+			}
+		}
+	}
+
+	var prefix string
+
+	value := importID
+
+	if sep := strings.LastIndex(importID, ","); sep != -1 {
+		prefix = importID[:sep]
+		value = importID[sep+1:]
+	}
+
+	switch hint {
+	case idImportHex:
+		bytes, err := hex.DecodeString(value)
+
+		return prefix, bytes, err
+	case idImportDec:
+		bytes, err := decodeIDDec(value, byteLength)
+
+		return prefix, bytes, err
+	case idImportB64Std:
+		bytes, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(value, "="))
+
+		return prefix, bytes, err
+	}
+
+	bytes, err := base64.RawURLEncoding.DecodeString(value)
+
+	return prefix, bytes, err
+}
+
+// decodeIDDec returns the bytes of the dec attribute of a random_id, padded with leading zeros
+// to byteLength, if it is greater than zero. The bytes of zero are a single zero byte.
+func decodeIDDec(value string, byteLength int64) ([]byte, error) {
+	var bigInt big.Int
+
+	if _, ok := bigInt.SetString(value, 10); !ok || bigInt.Sign() < 0 {
+		return nil, fmt.Errorf("%q is not a non-negative decimal integer", value)
+	}
+
+	bytes := bigInt.Bytes()
+
+	if byteLength == 0 {
+		byteLength = int64(len(bytes))
+
+		if byteLength == 0 {
+			byteLength = 1
+		}
+	}
+
+	if int64(len(bytes)) > byteLength {
+		return nil, fmt.Errorf("%s does not fit in a byte length of %d", value, byteLength)
+	}
+
+	padded := make([]byte, byteLength)
+	copy(padded[byteLength-int64(len(bytes)):], bytes)
+
+	return padded, nil
+}
+
 type idModelV0 struct {
 	ID         types.String `tfsdk:"id"`
 	Keepers    types.Map    `tfsdk:"keepers"`
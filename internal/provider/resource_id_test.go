@@ -1,9 +1,16 @@
 package provider
 
 import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccResourceID(t *testing.T) {
@@ -56,6 +63,213 @@ func TestAccResourceID_ImportWithPrefix(t *testing.T) {
 	})
 }
 
+func TestAccResourceID_ImportHex(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "test" {
+  							byte_length = 8
+  							prefix      = "cloud-"
+						}`,
+			},
+			{
+				ResourceName:      "random_id.test",
+				ImportState:       true,
+				ImportStateIdFunc: testIDImportIDFunc("random_id.test", "hex:", "hex"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceID_ImportDec(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "test" {
+  							byte_length = 8
+						}`,
+			},
+			{
+				ResourceName:      "random_id.test",
+				ImportState:       true,
+				ImportStateIdFunc: testIDImportIDFunc("random_id.test", "dec:8:", "dec"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestParseIDImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		importID       string
+		expectedPrefix string
+		expectedBytes  []byte
+		expectedError  bool
+	}{
+		"b64url": {
+			importID:      "p-9hUg",
+			expectedBytes: []byte{0xa7, 0xef, 0x61, 0x52},
+		},
+		"b64url-prefix": {
+			importID:       "my-prefix-,p-9hUg",
+			expectedPrefix: "my-prefix-",
+			expectedBytes:  []byte{0xa7, 0xef, 0x61, 0x52},
+		},
+		"b64url-hint": {
+			importID:      "b64url:p-9hUg",
+			expectedBytes: []byte{0xa7, 0xef, 0x61, 0x52},
+		},
+		"prefix-colon": {
+			importID:       "my:prefix-,p-9hUg",
+			expectedPrefix: "my:prefix-",
+			expectedBytes:  []byte{0xa7, 0xef, 0x61, 0x52},
+		},
+		"hex": {
+			importID:      "hex:00a7ef6152",
+			expectedBytes: []byte{0x00, 0xa7, 0xef, 0x61, 0x52},
+		},
+		"hex-prefix": {
+			importID:       "hex:my-prefix-,a7ef6152",
+			expectedPrefix: "my-prefix-",
+			expectedBytes:  []byte{0xa7, 0xef, 0x61, 0x52},
+		},
+		"b64std": {
+			importID:      "b64std:p+9hUg==",
+			expectedBytes: []byte{0xa7, 0xef, 0x61, 0x52},
+		},
+		"dec": {
+			importID:      "dec:2817483090",
+			expectedBytes: []byte{0xa7, 0xef, 0x61, 0x52},
+		},
+		"dec-byte-length": {
+			importID:      "dec:6:2817483090",
+			expectedBytes: []byte{0x00, 0x00, 0xa7, 0xef, 0x61, 0x52},
+		},
+		"dec-byte-length-prefix": {
+			importID:       "dec:6:my-prefix-,2817483090",
+			expectedPrefix: "my-prefix-",
+			expectedBytes:  []byte{0x00, 0x00, 0xa7, 0xef, 0x61, 0x52},
+		},
+		"dec-zero": {
+			importID:      "dec:0",
+			expectedBytes: []byte{0x00},
+		},
+		"dec-byte-length-too-small": {
+			importID:      "dec:2:2817483090",
+			expectedError: true,
+		},
+		"dec-byte-length-too-large": {
+			importID:      "dec:99999999999:1",
+			expectedError: true,
+		},
+		"dec-byte-length-negative": {
+			importID:      "dec:-1:1",
+			expectedError: true,
+		},
+		"dec-byte-length-zero": {
+			importID:      "dec:0:1",
+			expectedError: true,
+		},
+		"dec-negative": {
+			importID:      "dec:-1",
+			expectedError: true,
+		},
+		"hex-invalid": {
+			importID:      "hex:p-9hUg",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			prefix, got, err := parseIDImportID(testCase.importID)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Errorf("expected error, got: %q, %x", prefix, got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if prefix != testCase.expectedPrefix {
+				t.Errorf("expected prefix %q, got: %q", testCase.expectedPrefix, prefix)
+			}
+
+			if !bytes.Equal(got, testCase.expectedBytes) {
+				t.Errorf("expected bytes %x, got: %x", testCase.expectedBytes, got)
+			}
+		})
+	}
+}
+
+func TestAccResourceID_EntropySource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "entropy")
+
+	if err := os.WriteFile(path, []byte("abcd"), 0600); err != nil {
+		t.Fatalf("unexpected error writing file: %s", err)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`provider "random" {
+							entropy_source {
+								file = %q
+							}
+						}
+
+						resource "random_id" "foo" {
+							byte_length = 4
+						}`, path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_id.foo", "hex", "61626364"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceID_EntropySource_Exhausted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "entropy")
+
+	if err := os.WriteFile(path, []byte("abc"), 0600); err != nil {
+		t.Fatalf("unexpected error writing file: %s", err)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`provider "random" {
+							entropy_source {
+								file = %q
+							}
+						}
+
+						resource "random_id" "foo" {
+							byte_length = 4
+						}`, path),
+				ExpectError: regexp.MustCompile(`Randomness Generation Error`),
+			},
+		},
+	})
+}
+
 func TestAccResourceID_UpgradeFromVersion3_3_2(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		Steps: []resource.TestStep{
@@ -839,3 +1053,23 @@ func TestAccResourceID_Keepers_FrameworkMigration_NullMapValueToValue(t *testing
 		},
 	})
 }
+
+// testIDImportIDFunc returns the import ID of a random_id with the given hint, using the value of the
+// attribute without the prefix.
+func testIDImportIDFunc(resourceName, hint, attribute string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		prefix := rs.Primary.Attributes["prefix"]
+		value := strings.TrimPrefix(rs.Primary.Attributes[attribute], prefix)
+
+		if prefix != "" {
+			return fmt.Sprintf("%s%s,%s", hint, prefix, value), nil
+		}
+
+		return hint + value, nil
+	}
+}
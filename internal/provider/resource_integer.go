@@ -1,8 +1,11 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 
@@ -16,80 +19,34 @@ import (
 )
 
 var (
-	_ resource.Resource                = (*integerResource)(nil)
-	_ resource.ResourceWithImportState = (*integerResource)(nil)
+	_ resource.Resource                 = (*integerResource)(nil)
+	_ resource.ResourceWithConfigure    = (*integerResource)(nil)
+	_ resource.ResourceWithImportState  = (*integerResource)(nil)
+	_ resource.ResourceWithUpgradeState = (*integerResource)(nil)
 )
 
 func NewIntegerResource() resource.Resource {
 	return &integerResource{}
 }
 
-type integerResource struct{}
+type integerResource struct {
+	providerData *providerData
+}
 
 func (r *integerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_integer"
 }
 
 func (r *integerResource) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
-	return tfsdk.Schema{
-		Description: "The resource `random_integer` generates random values from a given range, described " +
-			"by the `min` and `max` attributes of a given resource.\n" +
-			"\n" +
-			"This resource can be used in conjunction with resources that have the `create_before_destroy` " +
-			"lifecycle flag set, to avoid conflicts with unique names during the brief period where both the " +
-			"old and new resources exist concurrently.",
-		Attributes: map[string]tfsdk.Attribute{
-			"keepers": {
-				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
-					"resource. See [the main provider documentation](../index.html) for more information.",
-				Type: types.MapType{
-					ElemType: types.StringType,
-				},
-				Optional: true,
-				PlanModifiers: []tfsdk.AttributePlanModifier{
-					planmodifiers.RequiresReplaceIfValuesNotNull(),
-				},
-			},
-			"min": {
-				Description:   "The minimum inclusive value of the range.",
-				Type:          types.Int64Type,
-				Required:      true,
-				PlanModifiers: []tfsdk.AttributePlanModifier{resource.RequiresReplace()},
-			},
-			"max": {
-				Description:   "The maximum inclusive value of the range.",
-				Type:          types.Int64Type,
-				Required:      true,
-				PlanModifiers: []tfsdk.AttributePlanModifier{resource.RequiresReplace()},
-			},
-			"seed": {
-				Description:   "A custom seed to always produce the same value.",
-				Type:          types.StringType,
-				Optional:      true,
-				PlanModifiers: []tfsdk.AttributePlanModifier{resource.RequiresReplace()},
-			},
-			"result": {
-				Description: "The random integer result.",
-				Type:        types.Int64Type,
-				Computed:    true,
-				PlanModifiers: []tfsdk.AttributePlanModifier{
-					resource.UseStateForUnknown(),
-				},
-			},
-			"id": {
-				Description: "The string representation of the integer result.",
-				Type:        types.StringType,
-				Computed:    true,
-				PlanModifiers: []tfsdk.AttributePlanModifier{
-					resource.UseStateForUnknown(),
-				},
-			},
-		},
-	}, nil
+	return integerSchemaV1(), nil
+}
+
+func (r *integerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.providerData = configureProviderData(req, resp)
 }
 
 func (r *integerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan integerModelV0
+	var plan integerModelV1
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -97,8 +54,8 @@ func (r *integerResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	max := int(plan.Max.Value)
-	min := int(plan.Min.Value)
+	max := plan.Max.Value
+	min := plan.Min.Value
 	seed := plan.Seed.Value
 
 	if max < min {
@@ -109,15 +66,36 @@ func (r *integerResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	rand := random.NewRand(seed)
-	number := rand.Intn((max+1)-min) + min
+	if _, ok := integerRangeSize(min, max); !ok {
+		resp.Diagnostics.AddError(
+			"Create Random Integer Error",
+			fmt.Sprintf("The range from the minimum (min) value to the maximum (max) value must contain at most "+
+				"%d integers, got: %d to %d.", math.MaxInt, min, max),
+		)
+		return
+	}
+
+	generator, diags := r.providerData.generator(ctx, "random_integer", plan.SeedAlgorithm.Value, seed, req.Config)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	number, err := generateInteger(generator, min, max)
+	if err != nil {
+		resp.Diagnostics.Append(randError(err)...)
+		return
+	}
 
-	u := &integerModelV0{
-		ID:      types.String{Value: strconv.Itoa(number)},
-		Keepers: plan.Keepers,
-		Min:     types.Int64{Value: int64(min)},
-		Max:     types.Int64{Value: int64(max)},
-		Result:  types.Int64{Value: int64(number)},
+	u := &integerModelV1{
+		ID:            types.String{Value: strconv.FormatInt(number, 10)},
+		Keepers:       plan.Keepers,
+		Min:           types.Int64{Value: min},
+		Max:           types.Int64{Value: max},
+		SeedAlgorithm: plan.SeedAlgorithm,
+		Result:        types.Int64{Value: number},
 	}
 
 	if seed != "" {
@@ -139,7 +117,7 @@ func (r *integerResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 // Update ensures the plan value is copied to the state to complete the update.
 func (r *integerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var model integerModelV0
+	var model integerModelV1
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
 
@@ -155,56 +133,145 @@ func (r *integerResource) Update(ctx context.Context, req resource.UpdateRequest
 func (r *integerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 }
 
-func (r *integerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, ",")
+// integerImportID is the import ID of random_integer. It is either a JSON object of the result and
+// attributes, like the import ID of random_shuffle, or {result},{min},{max} with an optional ,{seed}.
+type integerImportID struct {
+	Keepers       map[string]string `json:"keepers"`
+	Seed          *string           `json:"seed"`
+	SeedAlgorithm *string           `json:"seed_algorithm"`
+	Min           *int64            `json:"min"`
+	Max           *int64            `json:"max"`
+	Result        *int64            `json:"result"`
+}
+
+// parseIntegerImportID parses the import ID of random_integer.
+func parseIntegerImportID(id string) (integerImportID, error) {
+	var importID integerImportID
+
+	if strings.HasPrefix(id, "{") {
+		decoder := json.NewDecoder(bytes.NewBufferString(id))
+		decoder.DisallowUnknownFields()
+
+		if err := decoder.Decode(&importID); err != nil {
+			return importID, err
+		}
+
+		if importID.Result == nil || importID.Min == nil || importID.Max == nil {
+			return importID, fmt.Errorf("the result, min and max keys are required")
+		}
+
+		return importID, nil
+	}
+
+	parts := strings.Split(id, ",")
 	if len(parts) != 3 && len(parts) != 4 {
+		return importID, fmt.Errorf("expected 3 or 4 comma separated values, got: %d", len(parts))
+	}
+
+	values := make([]int64, 3)
+
+	for i, name := range []string{"result", "min", "max"} {
+		value, err := strconv.ParseInt(parts[i], 10, 64)
+		if err != nil {
+			return importID, fmt.Errorf("the %s value could not be parsed as an integer: %w", name, err)
+		}
+
+		values[i] = value
+	}
+
+	importID.Result, importID.Min, importID.Max = &values[0], &values[1], &values[2]
+
+	if len(parts) == 4 && parts[3] != "" {
+		importID.Seed = &parts[3]
+	}
+
+	return importID, nil
+}
+
+// ImportState sets the result and attributes of the resource from the import ID, which is either a JSON
+// object of the result, min and max, and optional seed, seed_algorithm and keepers, or the result, min and
+// max, and optional seed, separated by commas. The result must be between min and max. If a seed is given,
+// seed_algorithm is inferred as for random_shuffle, and a warning is added if the seed would not have
+// produced the result.
+func (r *integerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID, err := parseIntegerImportID(req.ID)
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Import Random Integer Error",
-			"Invalid import usage: expecting {result},{min},{max} or {result},{min},{max},{seed}",
+			"Invalid import usage: expecting {result},{min},{max}, {result},{min},{max},{seed} or a JSON object "+
+				"with result, min and max, and optional seed, seed_algorithm and keepers.\n\n"+
+				fmt.Sprintf("Original Error: %s", err),
 		)
 		return
 	}
 
-	result, err := strconv.ParseInt(parts[0], 10, 64)
-	if err != nil {
+	result, min, max := *importID.Result, *importID.Min, *importID.Max
+
+	if max < min {
 		resp.Diagnostics.AddError(
 			"Import Random Integer Error",
-			"The value supplied could not be parsed as an integer.\n\n"+
-				fmt.Sprintf("Original Error: %s", err),
+			"The minimum (min) value needs to be smaller than or equal to maximum (max) value.",
 		)
 		return
 	}
 
-	min, err := strconv.ParseInt(parts[1], 10, 64)
-	if err != nil {
+	if result < min || result > max {
 		resp.Diagnostics.AddError(
 			"Import Random Integer Error",
-			"The min value supplied could not be parsed as an integer.\n\n"+
-				fmt.Sprintf("Original Error: %s", err),
+			fmt.Sprintf("The result %d is not between the min (%d) and max (%d) values.", result, min, max),
 		)
 		return
 	}
 
-	max, err := strconv.ParseInt(parts[2], 10, 64)
-	if err != nil {
+	if importID.SeedAlgorithm != nil && !isSeedAlgorithm(*importID.SeedAlgorithm) {
 		resp.Diagnostics.AddError(
 			"Import Random Integer Error",
-			"The max value supplied could not be parsed as an integer.\n\n"+
-				fmt.Sprintf("Original Error: %s", err),
+			fmt.Sprintf("The seed_algorithm must be one of %q, got: %q.", random.SeedAlgorithms, *importID.SeedAlgorithm),
 		)
 		return
 	}
 
-	var state integerModelV0
+	var state integerModelV1
 
-	state.ID.Value = parts[0]
-	state.Keepers.ElemType = types.StringType
+	state.ID.Value = strconv.FormatInt(result, 10)
+	state.Keepers = importKeepers(importID.Keepers)
 	state.Result.Value = result
 	state.Min.Value = min
 	state.Max.Value = max
+	state.Seed.Null = true
+
+	// The result may have been generated by any provider version, so the
+	// algorithm used by earlier versions is assumed, unless one is given.
+	state.SeedAlgorithm.Value = random.SeedAlgorithmV1
+
+	if importID.SeedAlgorithm != nil {
+		state.SeedAlgorithm.Value = *importID.SeedAlgorithm
+	}
+
+	if importID.Seed != nil {
+		if _, ok := integerRangeSize(min, max); !ok {
+			resp.Diagnostics.AddError(
+				"Import Random Integer Error",
+				fmt.Sprintf("The result cannot be reproduced from the seed, as the range from the min (%d) to the max "+
+					"(%d) value contains more than %d integers.", min, max, math.MaxInt),
+			)
+			return
+		}
+
+		state.Seed = types.String{Value: *importID.Seed}
+
+		algorithm, diags := importSeedAlgorithm(*importID.Seed, importID.SeedAlgorithm, func(generator random.Generator) (bool, error) {
+			number, err := generateInteger(generator, min, max)
+
+			return number == result, err
+		})
+		resp.Diagnostics.Append(diags...)
 
-	if len(parts) == 4 {
-		state.Seed.Value = parts[3]
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.SeedAlgorithm.Value = algorithm
 	}
 
 	diags := resp.State.Set(ctx, &state)
@@ -214,11 +281,203 @@ func (r *integerResource) ImportState(ctx context.Context, req resource.ImportSt
 	}
 }
 
-type integerModelV0 struct {
-	ID      types.String `tfsdk:"id"`
-	Keepers types.Map    `tfsdk:"keepers"`
-	Min     types.Int64  `tfsdk:"min"`
-	Max     types.Int64  `tfsdk:"max"`
-	Seed    types.String `tfsdk:"seed"`
-	Result  types.Int64  `tfsdk:"result"`
+func (r *integerResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	schemaV0 := integerSchemaV0()
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &schemaV0,
+			StateUpgrader: upgradeIntegerStateV0toV1,
+		},
+	}
+}
+
+func upgradeIntegerStateV0toV1(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	type integerModelV0 struct {
+		ID      types.String `tfsdk:"id"`
+		Keepers types.Map    `tfsdk:"keepers"`
+		Min     types.Int64  `tfsdk:"min"`
+		Max     types.Int64  `tfsdk:"max"`
+		Seed    types.String `tfsdk:"seed"`
+		Result  types.Int64  `tfsdk:"result"`
+	}
+
+	var integerDataV0 integerModelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &integerDataV0)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Results in version 0 state were generated with the original seed algorithm.
+	integerDataV1 := integerModelV1{
+		ID:            integerDataV0.ID,
+		Keepers:       integerDataV0.Keepers,
+		Min:           integerDataV0.Min,
+		Max:           integerDataV0.Max,
+		Seed:          integerDataV0.Seed,
+		SeedAlgorithm: types.String{Value: random.SeedAlgorithmV1},
+		Result:        integerDataV0.Result,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, integerDataV1)...)
+}
+
+func integerSchemaV1() tfsdk.Schema {
+	return tfsdk.Schema{
+		Version: 1,
+		Description: "The resource `random_integer` generates random values from a given range, described " +
+			"by the `min` and `max` attributes of a given resource.\n" +
+			"\n" +
+			"This resource can be used in conjunction with resources that have the `create_before_destroy` " +
+			"lifecycle flag set, to avoid conflicts with unique names during the brief period where both the " +
+			"old and new resources exist concurrently.",
+		Attributes: map[string]tfsdk.Attribute{
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					planmodifiers.RequiresReplaceIfValuesNotNull(),
+				},
+			},
+			"min": {
+				Description:   "The minimum inclusive value of the range.",
+				Type:          types.Int64Type,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{resource.RequiresReplace()},
+			},
+			"max": {
+				Description:   "The maximum inclusive value of the range.",
+				Type:          types.Int64Type,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{resource.RequiresReplace()},
+			},
+			"seed": {
+				Description:   "A custom seed to always produce the same value.",
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{resource.RequiresReplace()},
+			},
+			"seed_algorithm": seedAlgorithmAttribute(),
+			"result": {
+				Description: "The random integer result.",
+				Type:        types.Int64Type,
+				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					resource.UseStateForUnknown(),
+				},
+			},
+			"id": {
+				Description: "The string representation of the integer result.",
+				Type:        types.StringType,
+				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					resource.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func integerSchemaV0() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "The resource `random_integer` generates random values from a given range, described " +
+			"by the `min` and `max` attributes of a given resource.\n" +
+			"\n" +
+			"This resource can be used in conjunction with resources that have the `create_before_destroy` " +
+			"lifecycle flag set, to avoid conflicts with unique names during the brief period where both the " +
+			"old and new resources exist concurrently.",
+		Attributes: map[string]tfsdk.Attribute{
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					planmodifiers.RequiresReplaceIfValuesNotNull(),
+				},
+			},
+			"min": {
+				Description:   "The minimum inclusive value of the range.",
+				Type:          types.Int64Type,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{resource.RequiresReplace()},
+			},
+			"max": {
+				Description:   "The maximum inclusive value of the range.",
+				Type:          types.Int64Type,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{resource.RequiresReplace()},
+			},
+			"seed": {
+				Description:   "A custom seed to always produce the same value.",
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{resource.RequiresReplace()},
+			},
+			"result": {
+				Description: "The random integer result.",
+				Type:        types.Int64Type,
+				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					resource.UseStateForUnknown(),
+				},
+			},
+			"id": {
+				Description: "The string representation of the integer result.",
+				Type:        types.StringType,
+				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					resource.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type integerModelV1 struct {
+	ID            types.String `tfsdk:"id"`
+	Keepers       types.Map    `tfsdk:"keepers"`
+	Min           types.Int64  `tfsdk:"min"`
+	Max           types.Int64  `tfsdk:"max"`
+	Seed          types.String `tfsdk:"seed"`
+	SeedAlgorithm types.String `tfsdk:"seed_algorithm"`
+	Result        types.Int64  `tfsdk:"result"`
+}
+
+// generateInteger returns a random integer between min and max, inclusive.
+// generateInteger returns an integer from min to max, inclusive, or an error if the number of integers in
+// the range is not an int, as the generator cannot generate from it.
+func generateInteger(generator random.Generator, min, max int64) (int64, error) {
+	size, ok := integerRangeSize(min, max)
+	if !ok {
+		return 0, fmt.Errorf("the range from min (%d) to max (%d) contains more than %d integers", min, max, math.MaxInt)
+	}
+
+	number, err := generator.Intn(size)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(number) + min, nil
+}
+
+// integerRangeSize returns the number of integers from min to max, inclusive, where min is not greater than
+// max, and false if the number is not an int. The number is computed as a uint64, in which it only overflows,
+// to zero, for the range of every int64.
+func integerRangeSize(min, max int64) (int, bool) {
+	size := uint64(max) - uint64(min) + 1
+
+	if size == 0 || size > math.MaxInt {
+		return 0, false
+	}
+
+	return int(size), true
 }
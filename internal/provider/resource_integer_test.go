@@ -2,6 +2,8 @@ package provider
 
 import (
 	"fmt"
+	"math"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -18,6 +20,7 @@ func TestAccResourceInteger(t *testing.T) {
    							min  = 1
 							max  = 3
    							seed = "12345"
+   							seed_algorithm = "v1"
 						}`,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("random_integer.integer_1", "result", "3"),
@@ -43,6 +46,7 @@ func TestAccResourceInteger_ChangeSeed(t *testing.T) {
    							min  = 1
 							max  = 3
    							seed = "12345"
+   							seed_algorithm = "v1"
 						}`,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("random_integer.integer_1", "result", "3"),
@@ -53,6 +57,7 @@ func TestAccResourceInteger_ChangeSeed(t *testing.T) {
 							min  = 1
    							max  = 3
    							seed = "123456"
+   							seed_algorithm = "v1"
 						}`,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("random_integer.integer_1", "result", "2"),
@@ -81,6 +86,7 @@ func TestAccResourceInteger_SeedlessToSeeded(t *testing.T) {
 							min  = 1
    							max  = 3
    							seed = "123456"
+   							seed_algorithm = "v1"
 						}`,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("random_integer.integer_1", "result", "2"),
@@ -100,6 +106,7 @@ func TestAccResourceInteger_SeededToSeedless(t *testing.T) {
    							min  = 1
 							max  = 3
    							seed = "12345"
+   							seed_algorithm = "v1"
 						}`,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("random_integer.integer_1", "result", "3"),
@@ -128,6 +135,7 @@ func TestAccResourceInteger_Big(t *testing.T) {
    							max  = 7227701560655103598
    							min  = 7227701560655103597
    							seed = 12345
+   							seed_algorithm = "v1"
 						}`,
 			},
 			{
@@ -140,6 +148,266 @@ func TestAccResourceInteger_Big(t *testing.T) {
 	})
 }
 
+func TestAccResourceInteger_SeedAlgorithm(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+   							min  = 1
+							max  = 1000000
+   							seed = "seed"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_integer.integer_1", "seed_algorithm", "v2"),
+					resource.TestCheckResourceAttr("random_integer.integer_1", "result", "987371"),
+				),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+   							min  = 1
+							max  = 1000000
+   							seed = "seed"
+   							seed_algorithm = "v1"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_integer.integer_1", "seed_algorithm", "v1"),
+					resource.TestCheckResourceAttr("random_integer.integer_1", "result", "299747"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_Import_SeedAlgorithm(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+   							min  = 1
+							max  = 1000000
+   							seed = "seed"
+						}`,
+			},
+			{
+				ResourceName:      "random_integer.integer_1",
+				ImportState:       true,
+				ImportStateId:     "987371,1,1000000,seed",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_Import_JSON(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min            = 1
+							max            = 1000000
+							seed           = "seed"
+							seed_algorithm = "v2"
+							keepers = {
+								key = "1"
+							}
+						}`,
+			},
+			{
+				ResourceName:      "random_integer.integer_1",
+				ImportState:       true,
+				ImportStateId:     `{"result": 987371, "min": 1, "max": 1000000, "seed": "seed", "seed_algorithm": "v2", "keepers": {"key": "1"}}`,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_Import_Errors(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+   							min = 1
+							max = 3
+						}`,
+				ResourceName:  "random_integer.integer_1",
+				ImportState:   true,
+				ImportStateId: "5,1,3",
+				ExpectError:   regexp.MustCompile(`The result 5 is not between the min \(1\) and max \(3\) values`),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+   							min = 1
+							max = 3
+						}`,
+				ResourceName:  "random_integer.integer_1",
+				ImportState:   true,
+				ImportStateId: "2,3,1",
+				ExpectError:   regexp.MustCompile(`The minimum \(min\) value needs to be smaller than or equal to maximum`),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+   							min = 1
+							max = 3
+						}`,
+				ResourceName:  "random_integer.integer_1",
+				ImportState:   true,
+				ImportStateId: `{"result": 2, "min": 1, "max": 3, "seed_algorithm": "v3"}`,
+				ExpectError:   regexp.MustCompile(`The seed_algorithm must be one of`),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+   							min = 1
+							max = 3
+						}`,
+				ResourceName:  "random_integer.integer_1",
+				ImportState:   true,
+				ImportStateId: "0,-9223372036854775808,9223372036854775807,seed",
+				ExpectError:   regexp.MustCompile(`The result cannot be reproduced from the seed`),
+			},
+		},
+	})
+}
+
+func TestParseIntegerImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		importID      string
+		expected      string
+		expectedError bool
+	}{
+		"comma": {
+			importID: "3,1,5",
+			expected: "result=3 min=1 max=5 seed=<nil> seed_algorithm=<nil> keepers=map[]",
+		},
+		"comma-seed": {
+			importID: "3,1,5,seed",
+			expected: "result=3 min=1 max=5 seed=seed seed_algorithm=<nil> keepers=map[]",
+		},
+		"comma-empty-seed": {
+			importID: "3,1,5,",
+			expected: "result=3 min=1 max=5 seed=<nil> seed_algorithm=<nil> keepers=map[]",
+		},
+		"json": {
+			importID: `{"result": 3, "min": 1, "max": 5, "seed": "seed", "seed_algorithm": "v2", "keepers": {"key": "1"}}`,
+			expected: "result=3 min=1 max=5 seed=seed seed_algorithm=v2 keepers=map[key:1]",
+		},
+		"comma-count": {
+			importID:      "3,1",
+			expectedError: true,
+		},
+		"comma-not-integer": {
+			importID:      "3,1,five",
+			expectedError: true,
+		},
+		"json-max-missing": {
+			importID:      `{"result": 3, "min": 1}`,
+			expectedError: true,
+		},
+		"json-unknown-key": {
+			importID:      `{"result": 3, "min": 1, "max": 5, "length": 1}`,
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseIntegerImportID(testCase.importID)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Errorf("expected error, got: %+v", got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			optional := func(s *string) string {
+				if s == nil {
+					return "<nil>"
+				}
+
+				return *s
+			}
+
+			actual := fmt.Sprintf("result=%d min=%d max=%d seed=%s seed_algorithm=%s keepers=%v", *got.Result, *got.Min,
+				*got.Max, optional(got.Seed), optional(got.SeedAlgorithm), got.Keepers)
+
+			if actual != testCase.expected {
+				t.Errorf("expected %s, got: %s", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestIntegerRangeSize(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		min, max     int64
+		expectedSize int
+		expectedOk   bool
+	}{
+		"single": {
+			min:          5,
+			max:          5,
+			expectedSize: 1,
+			expectedOk:   true,
+		},
+		"negative": {
+			min:          -3,
+			max:          3,
+			expectedSize: 7,
+			expectedOk:   true,
+		},
+		"max-int": {
+			min:          0,
+			max:          math.MaxInt - 1,
+			expectedSize: math.MaxInt,
+			expectedOk:   true,
+		},
+		"too-wide": {
+			min: -1,
+			max: math.MaxInt,
+		},
+		"every-int64": {
+			min: math.MinInt64,
+			max: math.MaxInt64,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			size, ok := integerRangeSize(testCase.min, testCase.max)
+
+			if ok != testCase.expectedOk || size != testCase.expectedSize {
+				t.Errorf("expected %d (%t), got: %d (%t)", testCase.expectedSize, testCase.expectedOk, size, ok)
+			}
+		})
+	}
+}
+
 func TestAccResourceInteger_UpgradeFromVersion3_3_2(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		Steps: []resource.TestStep{
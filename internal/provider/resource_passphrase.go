@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/planmodifiers"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+var (
+	_ resource.Resource                = (*passphraseResource)(nil)
+	_ resource.ResourceWithConfigure   = (*passphraseResource)(nil)
+	_ resource.ResourceWithImportState = (*passphraseResource)(nil)
+)
+
+// defaultPassphraseSeparator is the default separator of random_passphrase words. Unlike a
+// hyphen, it cannot be confused with the hyphens of words such as t-shirt.
+const defaultPassphraseSeparator = " "
+
+func NewPassphraseResource() resource.Resource {
+	return &passphraseResource{}
+}
+
+type passphraseResource struct {
+	providerData *providerData
+}
+
+func (r *passphraseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_passphrase"
+}
+
+func (r *passphraseResource) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "The resource `random_passphrase` generates a random passphrase of words chosen from the " +
+			"[EFF large word list](https://www.eff.org/deeplinks/2016/07/new-wordlists-random-passphrases), " +
+			"which is easier for a person to read and type than a [random_password](password.html) of the same " +
+			"entropy, such as the password of a break-glass account.\n" +
+			"\n" +
+			"This resource *does* use a cryptographic random number generator.",
+		Attributes: map[string]tfsdk.Attribute{
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					planmodifiers.RequiresReplaceIfValuesNotNull(),
+				},
+			},
+
+			"words": {
+				Description: "The number of words in the passphrase. Each word adds 12.9 bits of entropy. " +
+					"Default value is `6`.",
+				Type:     types.Int64Type,
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					planmodifiers.DefaultValue(types.Int64{Value: 6}),
+					planmodifiers.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(1),
+				},
+			},
+
+			"separator": {
+				Description: "The separator placed between each word of the passphrase. Default value is `\" \"`.",
+				Type:        types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					planmodifiers.DefaultValue(types.String{Value: defaultPassphraseSeparator}),
+					planmodifiers.RequiresReplace(),
+				},
+			},
+
+			"capitalize": {
+				Description: "Make the first letter of each word upper case. Default value is `false`.",
+				Type:        types.BoolType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					planmodifiers.DefaultValue(types.Bool{Value: false}),
+					planmodifiers.RequiresReplace(),
+				},
+			},
+
+			"include_number": {
+				Description: "Append a random digit to one of the words, chosen at random, for systems which " +
+					"require passwords to contain a number. Default value is `false`.",
+				Type:     types.BoolType,
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					planmodifiers.DefaultValue(types.Bool{Value: false}),
+					planmodifiers.RequiresReplace(),
+				},
+			},
+
+			"result": {
+				Description: "The generated random passphrase.",
+				Type:        types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					resource.UseStateForUnknown(),
+				},
+			},
+
+			"entropy_bits": {
+				Description: "The entropy of the result, in bits, derived from `words` and `include_number`. " +
+					"`separator` and `capitalize` do not add any entropy.",
+				Type:     types.Float64Type,
+				Computed: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					resource.UseStateForUnknown(),
+				},
+			},
+
+			"id": {
+				Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
+				Computed:    true,
+				Type:        types.StringType,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					resource.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (r *passphraseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.providerData = configureProviderData(req, resp)
+}
+
+func (r *passphraseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan passphraseModelV0
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := random.PassphraseParams{
+		Words:         plan.Words.Value,
+		Separator:     plan.Separator.Value,
+		Capitalize:    plan.Capitalize.Value,
+		IncludeNumber: plan.IncludeNumber.Value,
+	}
+
+	source, diags := r.providerData.randSource(ctx, "random_passphrase", req.Config)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := random.CreatePassphrase(source, params)
+	if err != nil {
+		resp.Diagnostics.Append(randError(err)...)
+		return
+	}
+
+	plan.ID = types.String{Value: "none"}
+	plan.Result = types.String{Value: result}
+	plan.EntropyBits = types.Float64{Value: params.EntropyBits()}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *passphraseResource) Read(context.Context, resource.ReadRequest, *resource.ReadResponse) {
+}
+
+// Update ensures the plan value is copied to the state to complete the update.
+func (r *passphraseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model passphraseModelV0
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *passphraseResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+// passphraseImportID is the extended import ID of random_passphrase, a JSON object of the result and
+// the attributes with which it was generated. Attributes which are omitted are inferred from the result.
+type passphraseImportID struct {
+	Result        *string           `json:"result"`
+	Keepers       map[string]string `json:"keepers"`
+	Words         *int64            `json:"words"`
+	Separator     *string           `json:"separator"`
+	Capitalize    *bool             `json:"capitalize"`
+	IncludeNumber *bool             `json:"include_number"`
+}
+
+// ImportState sets the result to the imported passphrase. The import ID is either the passphrase, whose
+// words are assumed to be separated by the default separator, or a JSON object of the result and its
+// attributes, in which case an error is returned if the result could not have been generated with them.
+// Attributes which are not given are inferred: capitalize and include_number from the case and digits
+// of the passphrase, and words from the number of separators.
+func (r *passphraseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var importID passphraseImportID
+
+	extended := isStringImportID(req.ID)
+
+	if extended {
+		decoder := json.NewDecoder(bytes.NewBufferString(req.ID))
+		decoder.DisallowUnknownFields()
+
+		err := decoder.Decode(&importID)
+		if err == nil && importID.Result == nil {
+			err = fmt.Errorf("the result key is required")
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Import Random Passphrase Error",
+				"Invalid import usage: expecting {passphrase} or a JSON object with result and optional words, "+
+					"separator, capitalize, include_number and keepers.\n\n"+
+					fmt.Sprintf("Original Error: %s", err),
+			)
+			return
+		}
+	} else {
+		importID.Result = &req.ID
+	}
+
+	id := *importID.Result
+	separator := stringOrDefault(importID.Separator, defaultPassphraseSeparator)
+	first, _ := utf8.DecodeRuneInString(id)
+
+	// The words of the word list are lower case, so only a capitalized passphrase begins with an upper
+	// case letter. Words such as t-shirt may contain the separator, in which case words must be given.
+	params := random.PassphraseParams{
+		Words:         int64OrDefault(importID.Words, int64(len(strings.Split(id, separator)))),
+		Separator:     separator,
+		Capitalize:    boolOrDefault(importID.Capitalize, unicode.IsUpper(first)),
+		IncludeNumber: boolOrDefault(importID.IncludeNumber, strings.ContainsAny(id, random.NumericChars)),
+	}
+
+	if extended {
+		if err := params.Verify(id); err != nil {
+			resp.Diagnostics.AddError(
+				"Import Random Passphrase Error",
+				fmt.Sprintf("The result could not have been generated with the attributes of the import ID: %s.", err),
+			)
+			return
+		}
+	}
+
+	state := passphraseModelV0{
+		ID:            types.String{Value: "none"},
+		Keepers:       importKeepers(importID.Keepers),
+		Words:         types.Int64{Value: params.Words},
+		Separator:     types.String{Value: params.Separator},
+		Capitalize:    types.Bool{Value: params.Capitalize},
+		IncludeNumber: types.Bool{Value: params.IncludeNumber},
+		Result:        types.String{Value: id},
+		EntropyBits:   types.Float64{Value: params.EntropyBits()},
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+type passphraseModelV0 struct {
+	ID            types.String  `tfsdk:"id"`
+	Keepers       types.Map     `tfsdk:"keepers"`
+	Words         types.Int64   `tfsdk:"words"`
+	Separator     types.String  `tfsdk:"separator"`
+	Capitalize    types.Bool    `tfsdk:"capitalize"`
+	IncludeNumber types.Bool    `tfsdk:"include_number"`
+	Result        types.String  `tfsdk:"result"`
+	EntropyBits   types.Float64 `tfsdk:"entropy_bits"`
+}
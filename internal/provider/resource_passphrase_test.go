@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourcePassphrase(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_passphrase" "basic" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("random_passphrase.basic", "result", regexp.MustCompile(`^[a-z-]+( [a-z-]+){5}$`)),
+					resource.TestCheckResourceAttr("random_passphrase.basic", "words", "6"),
+					resource.TestCheckResourceAttr("random_passphrase.basic", "separator", " "),
+					resource.TestMatchResourceAttr("random_passphrase.basic", "entropy_bits", regexp.MustCompile(`^77\.54`)),
+				),
+			},
+			{
+				ResourceName:      "random_passphrase.basic",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourcePassphrase_Options(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_passphrase" "test" {
+							words          = 4
+							separator      = "."
+							capitalize     = true
+							include_number = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("random_passphrase.test", "result", regexp.MustCompile(`^[A-Z][a-z-]*[0-9]?(\.[A-Z][a-z-]*[0-9]?){3}$`)),
+					resource.TestMatchResourceAttr("random_passphrase.test", "result", regexp.MustCompile(`[0-9]`)),
+					resource.TestMatchResourceAttr("random_passphrase.test", "entropy_bits", regexp.MustCompile(`^57\.0`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassphrase_ImportOptions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_passphrase" "test" {
+							words          = 4
+							capitalize     = true
+							include_number = true
+						}`,
+			},
+			{
+				ResourceName:      "random_passphrase.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourcePassphrase_ImportExtended(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_passphrase" "test" {
+							words      = 4
+							separator  = "-"
+							capitalize = true
+							keepers = {
+								key = "1"
+							}
+						}`,
+				ResourceName:       "random_passphrase.test",
+				ImportState:        true,
+				ImportStateId:      `{"result": "Correct-Pony-T-shirt-Staple", "words": 4, "separator": "-", "keepers": {"key": "1"}}`,
+				ImportStatePersist: true,
+			},
+			{
+				Config: `resource "random_passphrase" "test" {
+							words      = 4
+							separator  = "-"
+							capitalize = true
+							keepers = {
+								key = "1"
+							}
+						}`,
+				PlanOnly: true,
+			},
+			{
+				Config: `resource "random_passphrase" "test" {
+							words      = 4
+							separator  = "-"
+							capitalize = true
+							keepers = {
+								key = "1"
+							}
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_passphrase.test", "result", "Correct-Pony-T-shirt-Staple"),
+					resource.TestCheckResourceAttr("random_passphrase.test", "include_number", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassphrase_Import_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:        `resource "random_passphrase" "test" {}`,
+				ResourceName:  "random_passphrase.test",
+				ImportState:   true,
+				ImportStateId: `{"words": 4}`,
+				ExpectError:   regexp.MustCompile(`the result key is required`),
+			},
+			{
+				Config:        `resource "random_passphrase" "test" {}`,
+				ResourceName:  "random_passphrase.test",
+				ImportState:   true,
+				ImportStateId: `{"result": "correct pony", "length": 2}`,
+				ExpectError:   regexp.MustCompile(`Invalid import usage`),
+			},
+			{
+				Config:        `resource "random_passphrase" "test" {}`,
+				ResourceName:  "random_passphrase.test",
+				ImportState:   true,
+				ImportStateId: `{"result": "correct-pony-t-shirt", "separator": "-"}`,
+				ExpectError:   regexp.MustCompile(`The result could not have been generated with the attributes of the import ID`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassphrase_Keepers(t *testing.T) {
+	var result1, result2 string
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_passphrase" "test" {
+							keepers = {
+								key = "1"
+							}
+						}`,
+				Check: testExtractResourceAttr("random_passphrase.test", "result", &result1),
+			},
+			{
+				Config: `resource "random_passphrase" "test" {
+							keepers = {
+								key = "2"
+							}
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_passphrase.test", "result", &result2),
+					testCheckAttributeValuesDiffer(&result1, &result2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassphrase_Words_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_passphrase" "test" {
+							words = 0
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute words value must be at least 1`),
+			},
+		},
+	})
+}
@@ -3,8 +3,13 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
+	"regexp"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -19,7 +24,9 @@ import (
 
 var (
 	_ resource.Resource                 = (*passwordResource)(nil)
+	_ resource.ResourceWithConfigure    = (*passwordResource)(nil)
 	_ resource.ResourceWithImportState  = (*passwordResource)(nil)
+	_ resource.ResourceWithModifyPlan   = (*passwordResource)(nil)
 	_ resource.ResourceWithUpgradeState = (*passwordResource)(nil)
 )
 
@@ -27,7 +34,9 @@ func NewPasswordResource() resource.Resource {
 	return &passwordResource{}
 }
 
-type passwordResource struct{}
+type passwordResource struct {
+	providerData *providerData
+}
 
 func (r *passwordResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_password"
@@ -37,6 +46,42 @@ func (r *passwordResource) GetSchema(context.Context) (tfsdk.Schema, diag.Diagno
 	return passwordSchemaV3(), nil
 }
 
+func (r *passwordResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.providerData = configureProviderData(req, resp)
+}
+
+// ModifyPlan applies any provider defaults for attributes which have not been configured and
+// determines whether the resource requires replacement.
+func (r *passwordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var defaults *stringDefaultsModel
+
+	if r.providerData != nil {
+		defaults = r.providerData.passwordDefaults
+	}
+
+	var policy types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("policy"), &policy)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modifyStringPlan(ctx, defaults, policy, req, resp)
+
+	modifyBcryptHashPlan(ctx, req, resp)
+
+	modifyHtpasswdLinePlan(ctx, req, resp)
+
+	modifyPasswordHashesPlan(ctx, req, resp)
+
+	modifyStoreResultPlan(ctx, req, resp)
+
+	modifyRotationPlan(ctx, req, resp)
+
+	modifyPreviousResultPlan(ctx, req, resp)
+}
+
 func (r *passwordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan passwordModelV3
 
@@ -47,50 +92,142 @@ func (r *passwordResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	params := random.StringParams{
-		Length:          plan.Length.Value,
-		Upper:           plan.Upper.Value,
-		MinUpper:        plan.MinUpper.Value,
-		Lower:           plan.Lower.Value,
-		MinLower:        plan.MinLower.Value,
-		Numeric:         plan.Numeric.Value,
-		MinNumeric:      plan.MinNumeric.Value,
-		Special:         plan.Special.Value,
-		MinSpecial:      plan.MinSpecial.Value,
-		OverrideSpecial: plan.OverrideSpecial.Value,
-	}
-
-	result, err := random.CreateString(params)
+		Length:            plan.Length.Value,
+		Upper:             plan.Upper.Value,
+		MinUpper:          plan.MinUpper.Value,
+		Lower:             plan.Lower.Value,
+		MinLower:          plan.MinLower.Value,
+		Numeric:           plan.Numeric.Value,
+		MinNumeric:        plan.MinNumeric.Value,
+		Special:           plan.Special.Value,
+		MinSpecial:        plan.MinSpecial.Value,
+		OverrideSpecial:   plan.OverrideSpecial.Value,
+		CharacterClasses:  characterClasses(plan.CharacterClasses),
+		ExcludeCharacters: plan.ExcludeCharacters.Value,
+		ExcludeSimilar:    plan.ExcludeSimilar.Value,
+		Pronounceable:     plan.Mode.Value == stringModePronounceable,
+	}
+
+	source, diags := r.providerData.randSource(ctx, "random_password", req.Config)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := random.CreateString(source, params)
 	if err != nil {
-		resp.Diagnostics.Append(diagnostics.RandomReadError(err.Error())...)
+		resp.Diagnostics.Append(randError(err)...)
 		return
 	}
 
-	hash, err := generateHash(string(result))
+	hash, err := generateHash(string(result), bcryptCost(plan.BcryptCost))
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.HashGenerationError(err.Error())...)
+	}
+
+	hashes, err := generatePasswordHashes(r.providerData.saltSource("random_password", string(result)), string(result), plan.HashOptions)
 	if err != nil {
 		resp.Diagnostics.Append(diagnostics.HashGenerationError(err.Error())...)
 	}
 
 	plan.BcryptHash = types.String{Value: hash}
+
+	plan.HtpasswdLine, err = generateHtpasswdLine(r.providerData.saltSource("random_password", string(result)), string(result), plan.HtpasswdUsername, plan.HtpasswdFormat, plan.BcryptHash)
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.HashGenerationError(err.Error())...)
+	}
+
+	plan.Hashes = hashes
 	plan.ID = types.String{Value: "none"}
 	plan.Result = types.String{Value: string(result)}
 
+	// The result is only written to state when store_result is true. Otherwise, only the hashes generated
+	// from it above are stored.
+	if !storeResult(plan.StoreResult) {
+		plan.Result = types.String{Null: true}
+	}
+	plan.ByteLength = types.Int64{Value: int64(len(result))}
+
+	plan.CreatedAt, plan.ExpiresAt, err = rotationTimestamps(plan.CreatedAt, plan.RotationPeriod, plan.RotateAfter, time.Now())
+	if err != nil {
+		resp.Diagnostics.AddError("Create Random Password Error", err.Error())
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
-// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+// Read only sets entropy_bits and byte_length for resources created before the attributes were
+// introduced, as the state in ReadResourceResponse is otherwise already populated.
 func (r *passwordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readStringState(ctx, resp)
+	readRotationState(ctx, resp)
 }
 
-// Update ensures the plan value is copied to the state to complete the update.
+// Update ensures the plan value is copied to the state to complete the update. bcrypt_hash, htpasswd_line and
+// hashes are regenerated from the result if they are unknown, as bcrypt_cost, the htpasswd_* attributes or
+// hash_options have changed. The result is read from state when it is planned as null, as store_result is false.
+// The previous result planned into private state by ModifyPlan is cleared, as the resource has not been replaced.
 func (r *passwordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var model passwordModelV3
+	var result types.String
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("result"), &result)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if !model.Result.IsNull() {
+		result = model.Result
+	}
+
+	if model.BcryptHash.IsUnknown() {
+		hash, err := generateHash(result.Value, bcryptCost(model.BcryptCost))
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.HashGenerationError(err.Error())...)
+			return
+		}
+
+		model.BcryptHash = types.String{Value: hash}
+	}
+
+	if model.HtpasswdLine.IsUnknown() {
+		line, err := generateHtpasswdLine(r.providerData.saltSource("random_password", result.Value), result.Value, model.HtpasswdUsername, model.HtpasswdFormat, model.BcryptHash)
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.HashGenerationError(err.Error())...)
+			return
+		}
+
+		model.HtpasswdLine = line
+	}
+
+	if model.Hashes.IsUnknown() {
+		hashes, err := generatePasswordHashes(r.providerData.saltSource("random_password", result.Value), result.Value, model.HashOptions)
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.HashGenerationError(err.Error())...)
+			return
+		}
+
+		model.Hashes = hashes
+	}
+
+	if model.CreatedAt.IsUnknown() || model.ExpiresAt.IsUnknown() {
+		createdAt, expiresAt, err := rotationTimestamps(model.CreatedAt, model.RotationPeriod, model.RotateAfter, time.Now())
+		if err != nil {
+			resp.Diagnostics.AddError("Update Random Password Error", err.Error())
+			return
+		}
+
+		model.CreatedAt = createdAt
+		model.ExpiresAt = expiresAt
+	}
+
+	clearPreviousResultPrivate(ctx, resp)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
@@ -99,36 +236,64 @@ func (r *passwordResource) Update(ctx context.Context, req resource.UpdateReques
 func (r *passwordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 }
 
+// ImportState sets the result, and the attributes with which it was generated, from the import ID. See
+// importString for the import IDs which are accepted.
 func (r *passwordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id := req.ID
+	imported := importString(req.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := imported.Result.Value
 
 	state := passwordModelV3{
-		ID:         types.String{Value: "none"},
-		Result:     types.String{Value: id},
-		Length:     types.Int64{Value: int64(len(id))},
-		Special:    types.Bool{Value: true},
-		Upper:      types.Bool{Value: true},
-		Lower:      types.Bool{Value: true},
-		Number:     types.Bool{Value: true},
-		Numeric:    types.Bool{Value: true},
-		MinSpecial: types.Int64{Value: 0},
-		MinUpper:   types.Int64{Value: 0},
-		MinLower:   types.Int64{Value: 0},
-		MinNumeric: types.Int64{Value: 0},
-		Keepers: types.Map{
-			ElemType: types.StringType,
-			Null:     true,
-		},
-		OverrideSpecial: types.String{Null: true},
+		ID:                 types.String{Value: "none"},
+		Result:             imported.Result,
+		Length:             imported.Length,
+		ByteLength:         imported.ByteLength,
+		Special:            imported.Special,
+		Upper:              imported.Upper,
+		Lower:              imported.Lower,
+		Number:             imported.Numeric,
+		Numeric:            imported.Numeric,
+		MinSpecial:         imported.MinSpecial,
+		MinUpper:           imported.MinUpper,
+		MinLower:           imported.MinLower,
+		MinNumeric:         imported.MinNumeric,
+		Keepers:            imported.Keepers,
+		OverrideSpecial:    imported.OverrideSpecial,
+		MinEntropyBits:     types.Float64{Null: true},
+		EntropyBits:        imported.EntropyBits,
+		ExcludeCharacters:  imported.ExcludeCharacters,
+		ExcludeSimilar:     imported.ExcludeSimilar,
+		Mode:               imported.Mode,
+		Policy:             types.String{Null: true},
+		BcryptCost:         types.Int64{Null: true},
+		HtpasswdUsername:   types.String{Null: true},
+		HtpasswdFormat:     types.String{Null: true},
+		StoreResult:        types.Bool{Null: true},
+		RotationPeriod:     types.String{Null: true},
+		RotateAfter:        types.String{Null: true},
+		CreatedAt:          types.String{Value: time.Now().UTC().Truncate(time.Second).Format(time.RFC3339)},
+		ExpiresAt:          types.String{Null: true},
+		PreviousResult:     types.String{Null: true},
+		PreviousBcryptHash: types.String{Null: true},
+		CharacterClasses:   imported.CharacterClasses,
+		Hashes:             types.Object{AttrTypes: passwordHashesAttrTypes, Null: true},
 	}
 
-	hash, err := generateHash(id)
+	hash, err := generateHash(id, bcrypt.DefaultCost)
 	if err != nil {
 		resp.Diagnostics.Append(diagnostics.HashGenerationError(err.Error())...)
 	}
 
 	state.BcryptHash = types.String{Value: hash}
 
+	state.HtpasswdLine, err = generateHtpasswdLine(r.providerData.saltSource("random_password", id), id, state.HtpasswdUsername, state.HtpasswdFormat, state.BcryptHash)
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.HashGenerationError(err.Error())...)
+	}
+
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -245,23 +410,42 @@ func upgradePasswordStateV0toV3(ctx context.Context, req resource.UpgradeStateRe
 	}
 
 	passwordDataV3 := passwordModelV3{
-		Keepers:         passwordDataV0.Keepers,
-		Length:          length,
-		Special:         special,
-		Upper:           upper,
-		Lower:           lower,
-		Number:          number,
-		Numeric:         number,
-		MinNumeric:      minNumeric,
-		MinUpper:        minUpper,
-		MinLower:        minLower,
-		MinSpecial:      minSpecial,
-		OverrideSpecial: passwordDataV0.OverrideSpecial,
-		Result:          passwordDataV0.Result,
-		ID:              passwordDataV0.ID,
-	}
-
-	hash, err := generateHash(passwordDataV3.Result.Value)
+		ExcludeCharacters:  types.String{Null: true},
+		ExcludeSimilar:     types.Bool{Null: true},
+		Mode:               types.String{Null: true},
+		Policy:             types.String{Null: true},
+		MinEntropyBits:     types.Float64{Null: true},
+		EntropyBits:        types.Float64{Null: true},
+		ByteLength:         types.Int64{Null: true},
+		Hashes:             types.Object{Null: true, AttrTypes: passwordHashesAttrTypes},
+		BcryptCost:         types.Int64{Null: true},
+		HtpasswdUsername:   types.String{Null: true},
+		HtpasswdFormat:     types.String{Null: true},
+		HtpasswdLine:       types.String{Null: true},
+		StoreResult:        types.Bool{Null: true},
+		RotationPeriod:     types.String{Null: true},
+		RotateAfter:        types.String{Null: true},
+		CreatedAt:          types.String{Null: true},
+		ExpiresAt:          types.String{Null: true},
+		PreviousResult:     types.String{Null: true},
+		PreviousBcryptHash: types.String{Null: true},
+		Keepers:            passwordDataV0.Keepers,
+		Length:             length,
+		Special:            special,
+		Upper:              upper,
+		Lower:              lower,
+		Number:             number,
+		Numeric:            number,
+		MinNumeric:         minNumeric,
+		MinUpper:           minUpper,
+		MinLower:           minLower,
+		MinSpecial:         minSpecial,
+		OverrideSpecial:    passwordDataV0.OverrideSpecial,
+		Result:             passwordDataV0.Result,
+		ID:                 passwordDataV0.ID,
+	}
+
+	hash, err := generateHash(passwordDataV3.Result.Value, bcrypt.DefaultCost)
 	if err != nil {
 		resp.Diagnostics.Append(diagnostics.HashGenerationError(err.Error())...)
 		return
@@ -362,21 +546,40 @@ func upgradePasswordStateV1toV3(ctx context.Context, req resource.UpgradeStateRe
 	}
 
 	passwordDataV3 := passwordModelV3{
-		Keepers:         passwordDataV1.Keepers,
-		Length:          length,
-		Special:         special,
-		Upper:           upper,
-		Lower:           lower,
-		Number:          number,
-		Numeric:         number,
-		MinNumeric:      minNumeric,
-		MinUpper:        minUpper,
-		MinLower:        minLower,
-		MinSpecial:      minSpecial,
-		OverrideSpecial: passwordDataV1.OverrideSpecial,
-		BcryptHash:      passwordDataV1.BcryptHash,
-		Result:          passwordDataV1.Result,
-		ID:              passwordDataV1.ID,
+		ExcludeCharacters:  types.String{Null: true},
+		ExcludeSimilar:     types.Bool{Null: true},
+		Mode:               types.String{Null: true},
+		Policy:             types.String{Null: true},
+		MinEntropyBits:     types.Float64{Null: true},
+		EntropyBits:        types.Float64{Null: true},
+		ByteLength:         types.Int64{Null: true},
+		Hashes:             types.Object{Null: true, AttrTypes: passwordHashesAttrTypes},
+		BcryptCost:         types.Int64{Null: true},
+		HtpasswdUsername:   types.String{Null: true},
+		HtpasswdFormat:     types.String{Null: true},
+		HtpasswdLine:       types.String{Null: true},
+		StoreResult:        types.Bool{Null: true},
+		RotationPeriod:     types.String{Null: true},
+		RotateAfter:        types.String{Null: true},
+		CreatedAt:          types.String{Null: true},
+		ExpiresAt:          types.String{Null: true},
+		PreviousResult:     types.String{Null: true},
+		PreviousBcryptHash: types.String{Null: true},
+		Keepers:            passwordDataV1.Keepers,
+		Length:             length,
+		Special:            special,
+		Upper:              upper,
+		Lower:              lower,
+		Number:             number,
+		Numeric:            number,
+		MinNumeric:         minNumeric,
+		MinUpper:           minUpper,
+		MinLower:           minLower,
+		MinSpecial:         minSpecial,
+		OverrideSpecial:    passwordDataV1.OverrideSpecial,
+		BcryptHash:         passwordDataV1.BcryptHash,
+		Result:             passwordDataV1.Result,
+		ID:                 passwordDataV1.ID,
 	}
 
 	diags := resp.State.Set(ctx, passwordDataV3)
@@ -484,21 +687,40 @@ func upgradePasswordStateV2toV3(ctx context.Context, req resource.UpgradeStateRe
 	// however the BcryptHash value may have been incorrectly generated.
 	//nolint:gosimple // V3 model will expand over time so all fields are written out to help future code changes.
 	passwordDataV3 := passwordModelV3{
-		BcryptHash:      passwordDataV2.BcryptHash,
-		ID:              passwordDataV2.ID,
-		Keepers:         passwordDataV2.Keepers,
-		Length:          length,
-		Lower:           lower,
-		MinLower:        minLower,
-		MinNumeric:      minNumeric,
-		MinSpecial:      minSpecial,
-		MinUpper:        minUpper,
-		Number:          number,
-		Numeric:         numeric,
-		OverrideSpecial: passwordDataV2.OverrideSpecial,
-		Result:          passwordDataV2.Result,
-		Special:         special,
-		Upper:           upper,
+		ExcludeCharacters:  types.String{Null: true},
+		ExcludeSimilar:     types.Bool{Null: true},
+		Mode:               types.String{Null: true},
+		Policy:             types.String{Null: true},
+		MinEntropyBits:     types.Float64{Null: true},
+		EntropyBits:        types.Float64{Null: true},
+		ByteLength:         types.Int64{Null: true},
+		Hashes:             types.Object{Null: true, AttrTypes: passwordHashesAttrTypes},
+		BcryptCost:         types.Int64{Null: true},
+		HtpasswdUsername:   types.String{Null: true},
+		HtpasswdFormat:     types.String{Null: true},
+		HtpasswdLine:       types.String{Null: true},
+		StoreResult:        types.Bool{Null: true},
+		RotationPeriod:     types.String{Null: true},
+		RotateAfter:        types.String{Null: true},
+		CreatedAt:          types.String{Null: true},
+		ExpiresAt:          types.String{Null: true},
+		PreviousResult:     types.String{Null: true},
+		PreviousBcryptHash: types.String{Null: true},
+		BcryptHash:         passwordDataV2.BcryptHash,
+		ID:                 passwordDataV2.ID,
+		Keepers:            passwordDataV2.Keepers,
+		Length:             length,
+		Lower:              lower,
+		MinLower:           minLower,
+		MinNumeric:         minNumeric,
+		MinSpecial:         minSpecial,
+		MinUpper:           minUpper,
+		Number:             number,
+		Numeric:            numeric,
+		OverrideSpecial:    passwordDataV2.OverrideSpecial,
+		Result:             passwordDataV2.Result,
+		Special:            special,
+		Upper:              upper,
 	}
 
 	// Set the duplicated data now so we can easily return early below.
@@ -546,8 +768,8 @@ func upgradePasswordStateV2toV3(ctx context.Context, req resource.UpgradeStateRe
 	resp.Diagnostics.Append(resp.State.Set(ctx, passwordDataV3)...)
 }
 
-func generateHash(toHash string) (string, error) {
-	hash, err := bcrypt.GenerateFromPassword([]byte(toHash), bcrypt.DefaultCost)
+func generateHash(toHash string, cost int) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(toHash), cost)
 
 	return string(hash), err
 }
@@ -573,14 +795,30 @@ func passwordSchemaV3() tfsdk.Schema {
 				},
 			},
 
-			"length": {
-				Description: "The length of the string desired. The minimum value for length is 1 and, length " +
-					"must also be >= (`min_upper` + `min_lower` + `min_numeric` + `min_special`).",
-				Type:     types.Int64Type,
-				Required: true,
+			"policy": {
+				Description: "A preset of attribute values producing passwords which are accepted by a " +
+					"particular system. Attributes which are not set take their value from the policy, and " +
+					"attributes which are set may tighten, but not violate, the policy. Changing this forces " +
+					"a new resource to be created. See [Password Policies](#password-policies) for the " +
+					"available policies.",
+				Type:     types.StringType,
+				Optional: true,
+				Validators: []tfsdk.AttributeValidator{
+					stringvalidator.OneOf(passwordPolicyNames()...),
+				},
 				PlanModifiers: []tfsdk.AttributePlanModifier{
 					resource.RequiresReplace(),
 				},
+			},
+
+			"length": {
+				Description: "The length of the string desired, in characters. The minimum value for length is 1 " +
+					"and, length must also be >= (`min_upper` + `min_lower` + `min_numeric` + `min_special` + the " +
+					"`min` of each `character_class`). " +
+					"Required unless `policy` is set, or a default length is set in the provider `defaults` block.",
+				Type:     types.Int64Type,
+				Optional: true,
+				Computed: true,
 				Validators: []tfsdk.AttributeValidator{
 					int64validator.AtLeast(1),
 					int64validator.AtLeastSumOf(
@@ -599,7 +837,6 @@ func passwordSchemaV3() tfsdk.Schema {
 				Computed:    true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
 					planmodifiers.DefaultValue(types.Bool{Value: true}),
-					planmodifiers.RequiresReplace(),
 				},
 			},
 
@@ -610,7 +847,6 @@ func passwordSchemaV3() tfsdk.Schema {
 				Computed:    true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
 					planmodifiers.DefaultValue(types.Bool{Value: true}),
-					planmodifiers.RequiresReplace(),
 				},
 			},
 
@@ -621,7 +857,6 @@ func passwordSchemaV3() tfsdk.Schema {
 				Computed:    true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
 					planmodifiers.DefaultValue(types.Bool{Value: true}),
-					planmodifiers.RequiresReplace(),
 				},
 			},
 
@@ -633,7 +868,6 @@ func passwordSchemaV3() tfsdk.Schema {
 				Computed: true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
 					planmodifiers.NumberNumericAttributePlanModifier(),
-					planmodifiers.RequiresReplace(),
 				},
 				DeprecationMessage: "**NOTE**: This is deprecated, use `numeric` instead.",
 			},
@@ -645,7 +879,6 @@ func passwordSchemaV3() tfsdk.Schema {
 				Computed:    true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
 					planmodifiers.NumberNumericAttributePlanModifier(),
-					planmodifiers.RequiresReplace(),
 				},
 			},
 
@@ -656,7 +889,6 @@ func passwordSchemaV3() tfsdk.Schema {
 				Computed:    true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
 					planmodifiers.DefaultValue(types.Int64{Value: 0}),
-					planmodifiers.RequiresReplace(),
 				},
 			},
 
@@ -667,7 +899,6 @@ func passwordSchemaV3() tfsdk.Schema {
 				Computed:    true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
 					planmodifiers.DefaultValue(types.Int64{Value: 0}),
-					planmodifiers.RequiresReplace(),
 				},
 			},
 
@@ -678,7 +909,6 @@ func passwordSchemaV3() tfsdk.Schema {
 				Computed:    true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
 					planmodifiers.DefaultValue(types.Int64{Value: 0}),
-					planmodifiers.RequiresReplace(),
 				},
 			},
 
@@ -689,7 +919,6 @@ func passwordSchemaV3() tfsdk.Schema {
 				Computed:    true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
 					planmodifiers.DefaultValue(types.Int64{Value: 0}),
-					planmodifiers.RequiresReplace(),
 				},
 			},
 
@@ -699,17 +928,67 @@ func passwordSchemaV3() tfsdk.Schema {
 					"still be set to true for any overwritten characters to be used in generation.",
 				Type:     types.StringType,
 				Optional: true,
+				// Computed, as the planned value can be set from the provider defaults.
+				Computed: true,
+			},
+
+			"exclude_characters": {
+				Description: "Characters which are never used in the result. Excluded characters are removed from " +
+					"every class of characters before the characters satisfying the `min_*` attributes are chosen.",
+				Type:     types.StringType,
+				Optional: true,
+				Validators: []tfsdk.AttributeValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"exclude_similar": {
+				Description: "Exclude characters which are easily mistaken for one another when read by a person " +
+					"(`0O1lI|` and backtick) from the result. Default value is `false`.",
+				Type:     types.BoolType,
+				Optional: true,
+			},
+
+			"mode": modeAttribute(),
+
+			"min_entropy_bits": {
+				Description: "The minimum entropy, in bits, of the result. Planning fails if `entropy_bits` " +
+					"would be less than this value.",
+				Type:     types.Float64Type,
+				Optional: true,
+				Validators: []tfsdk.AttributeValidator{
+					float64validator.AtLeast(0),
+				},
+			},
+
+			"entropy_bits": {
+				Description: "An estimate of the entropy of the result, in bits, derived from `length` and the " +
+					"characters which may be used in the result. Characters required by the `min_*` " +
+					"attributes and `character_class` blocks contribute only the entropy of their own class.",
+				Type:     types.Float64Type,
+				Computed: true,
+			},
+
+			"byte_length": {
+				Description: "The length of the result in bytes, when encoded as UTF-8. This differs from " +
+					"`length` when the result contains multi-byte characters.",
+				Type:     types.Int64Type,
+				Computed: true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
-					resource.RequiresReplaceIf(
-						planmodifiers.RequiresReplaceUnlessEmptyStringToNull(),
-						"Replace on modification unless updating from empty string (\"\") to null.",
-						"Replace on modification unless updating from empty string (`\"\"`) to `null`.",
-					),
+					resource.UseStateForUnknown(),
 				},
 			},
 
+			"rotation_period": rotationPeriodAttribute(),
+
+			"rotate_after": rotateAfterAttribute(),
+
+			"created_at": createdAtAttribute(),
+
+			"expires_at": expiresAtAttribute(),
+
 			"result": {
-				Description: "The generated random string.",
+				Description: "The generated random string. Null when `store_result` is `false`.",
 				Type:        types.StringType,
 				Computed:    true,
 				Sensitive:   true,
@@ -718,6 +997,44 @@ func passwordSchemaV3() tfsdk.Schema {
 				},
 			},
 
+			"previous_result": {
+				Description: "The result of the resource which this resource replaced, which may be used to keep " +
+					"both the current and previous results valid while rotating them. Null when the resource has " +
+					"not replaced another, and when `store_result` is `false`.",
+				Type:      types.StringType,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"previous_bcrypt_hash": {
+				Description: "The `bcrypt_hash` of the resource which this resource replaced. Null when the " +
+					"resource has not replaced another.",
+				Type:      types.StringType,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"store_result": {
+				Description: "Whether the result is stored in state. When `false`, the result is never written " +
+					"to state and is always null, and only `bcrypt_hash`, `hashes` and `htpasswd_line` are " +
+					"stored. Setting this to `true` for a resource without a stored result, or changing an " +
+					"attribute from which those hashes are generated, forces a new resource to be created. " +
+					"Default value is `true`.",
+				Type:     types.BoolType,
+				Optional: true,
+			},
+
+			"bcrypt_cost": {
+				Description: fmt.Sprintf("The cost of `bcrypt_hash`, between %d and %d. Changing this "+
+					"regenerates `bcrypt_hash` from the existing result. Default value is `%d`.",
+					bcrypt.MinCost, bcrypt.MaxCost, bcrypt.DefaultCost),
+				Type:     types.Int64Type,
+				Optional: true,
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.Between(int64(bcrypt.MinCost), int64(bcrypt.MaxCost)),
+				},
+			},
+
 			"bcrypt_hash": {
 				Description: "A bcrypt hash of the generated random string.",
 				Type:        types.StringType,
@@ -728,6 +1045,39 @@ func passwordSchemaV3() tfsdk.Schema {
 				},
 			},
 
+			"htpasswd_username": {
+				Description: "The username of `htpasswd_line`. Changing this regenerates `htpasswd_line` from the " +
+					"existing result.",
+				Type:     types.StringType,
+				Optional: true,
+				Validators: []tfsdk.AttributeValidator{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^:\r\n]*$`), "must not contain a colon or a line break"),
+				},
+			},
+
+			"htpasswd_format": {
+				Description: "The format of the hash in `htpasswd_line`, one of `apr1` (Apache MD5), `bcrypt` or " +
+					"`sha1`. Changing this regenerates `htpasswd_line` from the existing result. Default value is " +
+					"`bcrypt`.",
+				Type:     types.StringType,
+				Optional: true,
+				Validators: []tfsdk.AttributeValidator{
+					stringvalidator.OneOf(htpasswdFormatNames()...),
+				},
+			},
+
+			"htpasswd_line": {
+				Description: "A line of an Apache htpasswd file, `<htpasswd_username>:<hash>`, which may be used " +
+					"for HTTP basic authentication. The `bcrypt` format uses `bcrypt_hash`. Only set when " +
+					"`htpasswd_username` is set.",
+				Type:      types.StringType,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"hashes": passwordHashesAttribute(),
+
 			"id": {
 				Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
 				Computed:    true,
@@ -737,6 +1087,10 @@ func passwordSchemaV3() tfsdk.Schema {
 				},
 			},
 		},
+		Blocks: map[string]tfsdk.Block{
+			"character_class": characterClassBlock(),
+			"hash_options":    hashOptionsBlock(),
+		},
 	}
 }
 
@@ -1239,19 +1593,40 @@ func passwordSchemaV0() tfsdk.Schema {
 }
 
 type passwordModelV3 struct {
-	ID              types.String `tfsdk:"id"`
-	Keepers         types.Map    `tfsdk:"keepers"`
-	Length          types.Int64  `tfsdk:"length"`
-	Special         types.Bool   `tfsdk:"special"`
-	Upper           types.Bool   `tfsdk:"upper"`
-	Lower           types.Bool   `tfsdk:"lower"`
-	Number          types.Bool   `tfsdk:"number"`
-	Numeric         types.Bool   `tfsdk:"numeric"`
-	MinNumeric      types.Int64  `tfsdk:"min_numeric"`
-	MinUpper        types.Int64  `tfsdk:"min_upper"`
-	MinLower        types.Int64  `tfsdk:"min_lower"`
-	MinSpecial      types.Int64  `tfsdk:"min_special"`
-	OverrideSpecial types.String `tfsdk:"override_special"`
-	Result          types.String `tfsdk:"result"`
-	BcryptHash      types.String `tfsdk:"bcrypt_hash"`
+	ID                 types.String          `tfsdk:"id"`
+	Keepers            types.Map             `tfsdk:"keepers"`
+	Length             types.Int64           `tfsdk:"length"`
+	Special            types.Bool            `tfsdk:"special"`
+	Upper              types.Bool            `tfsdk:"upper"`
+	Lower              types.Bool            `tfsdk:"lower"`
+	Number             types.Bool            `tfsdk:"number"`
+	Numeric            types.Bool            `tfsdk:"numeric"`
+	MinNumeric         types.Int64           `tfsdk:"min_numeric"`
+	MinUpper           types.Int64           `tfsdk:"min_upper"`
+	MinLower           types.Int64           `tfsdk:"min_lower"`
+	MinSpecial         types.Int64           `tfsdk:"min_special"`
+	OverrideSpecial    types.String          `tfsdk:"override_special"`
+	Result             types.String          `tfsdk:"result"`
+	StoreResult        types.Bool            `tfsdk:"store_result"`
+	PreviousResult     types.String          `tfsdk:"previous_result"`
+	PreviousBcryptHash types.String          `tfsdk:"previous_bcrypt_hash"`
+	RotationPeriod     types.String          `tfsdk:"rotation_period"`
+	RotateAfter        types.String          `tfsdk:"rotate_after"`
+	CreatedAt          types.String          `tfsdk:"created_at"`
+	ExpiresAt          types.String          `tfsdk:"expires_at"`
+	BcryptCost         types.Int64           `tfsdk:"bcrypt_cost"`
+	BcryptHash         types.String          `tfsdk:"bcrypt_hash"`
+	HtpasswdUsername   types.String          `tfsdk:"htpasswd_username"`
+	HtpasswdFormat     types.String          `tfsdk:"htpasswd_format"`
+	HtpasswdLine       types.String          `tfsdk:"htpasswd_line"`
+	Policy             types.String          `tfsdk:"policy"`
+	MinEntropyBits     types.Float64         `tfsdk:"min_entropy_bits"`
+	EntropyBits        types.Float64         `tfsdk:"entropy_bits"`
+	ByteLength         types.Int64           `tfsdk:"byte_length"`
+	Hashes             types.Object          `tfsdk:"hashes"`
+	HashOptions        []hashOptionsModel    `tfsdk:"hash_options"`
+	ExcludeCharacters  types.String          `tfsdk:"exclude_characters"`
+	ExcludeSimilar     types.Bool            `tfsdk:"exclude_similar"`
+	Mode               types.String          `tfsdk:"mode"`
+	CharacterClasses   []characterClassModel `tfsdk:"character_class"`
 }
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"regexp"
@@ -25,6 +26,7 @@ func TestGenerateHash(t *testing.T) {
 
 	testCases := map[string]struct {
 		input random.StringParams
+		cost  int
 	}{
 		"defaults": {
 			input: random.StringParams{
@@ -34,6 +36,17 @@ func TestGenerateHash(t *testing.T) {
 				Special: true,
 				Upper:   true,
 			},
+			cost: bcrypt.DefaultCost,
+		},
+		"min-cost": {
+			input: random.StringParams{
+				Length:  32, // Required
+				Lower:   true,
+				Numeric: true,
+				Special: true,
+				Upper:   true,
+			},
+			cost: bcrypt.MinCost,
 		},
 	}
 
@@ -43,18 +56,22 @@ func TestGenerateHash(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			randomBytes, err := random.CreateString(testCase.input)
+			randomBytes, err := random.CreateString(rand.Reader, testCase.input)
 
 			if err != nil {
 				t.Fatalf("unexpected random.CreateString error: %s", err)
 			}
 
-			hash, err := generateHash(string(randomBytes))
+			hash, err := generateHash(string(randomBytes), testCase.cost)
 
 			if err != nil {
 				t.Fatalf("unexpected generateHash error: %s", err)
 			}
 
+			if cost, _ := bcrypt.Cost([]byte(hash)); cost != testCase.cost {
+				t.Fatalf("expected cost %d, got: %d", testCase.cost, cost)
+			}
+
 			err = bcrypt.CompareHashAndPassword([]byte(hash), randomBytes)
 
 			if err != nil {
@@ -95,7 +112,7 @@ func TestAccResourcePassword_Import(t *testing.T) {
 				},
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"bcrypt_hash"},
+				ImportStateVerifyIgnore: []string{"bcrypt_hash", "created_at", "hashes"},
 			},
 		},
 	})
@@ -123,6 +140,50 @@ func TestAccResourcePassword_BcryptHash(t *testing.T) {
 	})
 }
 
+func TestAccResourcePassword_BcryptCost(t *testing.T) {
+	t.Parallel()
+
+	var result1, result2, bcryptHash1, bcryptHash2 string
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length      = 12
+							bcrypt_cost = 5
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_password.test", "bcrypt_hash", &bcryptHash1),
+					testExtractResourceAttr("random_password.test", "result", &result1),
+					testBcryptHashValid(&bcryptHash1, &result1),
+					resource.TestMatchResourceAttr("random_password.test", "bcrypt_hash", regexp.MustCompile(`^\$2a\$05\$`)),
+				),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length      = 12
+							bcrypt_cost = 6
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_password.test", "bcrypt_hash", &bcryptHash2),
+					testExtractResourceAttr("random_password.test", "result", &result2),
+					testCheckAttributeValuesEqual(&result1, &result2),
+					testBcryptHashValid(&bcryptHash2, &result2),
+					resource.TestMatchResourceAttr("random_password.test", "bcrypt_hash", regexp.MustCompile(`^\$2a\$06\$`)),
+				),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length      = 12
+							bcrypt_cost = 3
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute bcrypt_cost value must be between 4 and 31, got: 3`),
+			},
+		},
+	})
+}
+
 // TestAccResourcePassword_BcryptHash_FromVersion3_3_2 verifies behaviour when
 // upgrading state from schema V2 to V3 without a bcrypt_hash update.
 func TestAccResourcePassword_BcryptHash_FromVersion3_3_2(t *testing.T) {
@@ -889,6 +950,446 @@ func TestAccResourcePassword_Min(t *testing.T) {
 	})
 }
 
+func TestAccResourcePassword_CharacterClass(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "character_class" {
+							length  = 16
+							upper   = false
+							numeric = false
+							special = false
+
+							character_class {
+								name       = "hex"
+								characters = "0123456789abcdef"
+								min        = 4
+								max        = 6
+							}
+
+							character_class {
+								name       = "symbols"
+								characters = "~^"
+								min        = 1
+								max        = 1
+							}
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("random_password.character_class", "result", testCheckLen(16)),
+					resource.TestMatchResourceAttr("random_password.character_class", "result", regexp.MustCompile(`^[a-z0-9]*[~^][a-z0-9]*$`)),
+					resource.TestMatchResourceAttr("random_password.character_class", "result", regexp.MustCompile(`^([^0-9a-f]*[0-9a-f]){4,6}[^0-9a-f]*$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_CharacterClass_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "character_class" {
+							length = 8
+
+							character_class {
+								name       = "symbols"
+								characters = "~^"
+							}
+
+							character_class {
+								name       = "symbols"
+								characters = "|"
+							}
+						}`,
+				ExpectError: regexp.MustCompile(`.*Each character_class block must have a unique name, got duplicate:\s"symbols"`),
+			},
+			{
+				Config: `resource "random_password" "character_class" {
+							length = 8
+
+							character_class {
+								name       = "symbols"
+								characters = "~^"
+								min        = 3
+								max        = 2
+							}
+						}`,
+				ExpectError: regexp.MustCompile(`.*The min of a character_class must not exceed its max \(2\), got: 3`),
+			},
+			{
+				Config: `resource "random_password" "character_class" {
+							length    = 4
+							min_upper = 2
+
+							character_class {
+								name       = "symbols"
+								characters = "~^"
+								min        = 3
+							}
+						}`,
+				ExpectError: regexp.MustCompile(`.*The length must be at least the sum of min_upper, min_lower, min_numeric,\smin_special and the min of each character_class \(5\), got: 4`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_EntropyBits(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "entropy" {
+							length           = 16
+							min_entropy_bits = 100
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_password.entropy", "entropy_bits", "102"),
+				),
+			},
+			{
+				Config: `resource "random_password" "entropy" {
+							length           = 16
+							min_entropy_bits = 128
+						}`,
+				ExpectError: regexp.MustCompile(`.*The estimated entropy of the result \(102.00 bits\) is less than`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_Exclude(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "exclude" {
+							length             = 64
+							override_special   = "!#|-"
+							exclude_similar    = true
+							exclude_characters = "abc-"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("random_password.exclude", "result", testCheckLen(64)),
+					resource.TestMatchResourceAttr("random_password.exclude", "result", regexp.MustCompile("^[^0O1lI|`abc-]+$")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_Exclude_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "exclude" {
+							length           = 8
+							override_special = "|"
+							min_special      = 1
+							exclude_similar  = true
+						}`,
+				ExpectError: regexp.MustCompile(`.*Every character of the special character class is excluded by`),
+			},
+			{
+				Config: `resource "random_password" "exclude" {
+							length             = 8
+							upper              = false
+							lower              = false
+							special            = false
+							exclude_characters = "0123456789"
+						}`,
+				ExpectError: regexp.MustCompile(`.*Every character which may be used in the result is either disabled or`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_MultiByte(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "multi_byte" {
+							length           = 12
+							special          = true
+							upper            = false
+							lower            = false
+							numeric          = false
+							override_special = "€§"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("random_password.multi_byte", "result", regexp.MustCompile(`^[€§]{12}$`)),
+					resource.TestCheckResourceAttr("random_password.multi_byte", "length", "12"),
+					// € is 3 bytes and § is 2 bytes when encoded as UTF-8.
+					resource.TestCheckResourceAttrWith("random_password.multi_byte", "byte_length", testCheckIntBetween(24, 36)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_Pronounceable(t *testing.T) {
+	var result1, result2 string
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "pronounceable" {
+							length      = 8
+							mode        = "pronounceable"
+							min_numeric = 2
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_password.pronounceable", "result", &result1),
+					resource.TestMatchResourceAttr("random_password.pronounceable", "result", regexp.MustCompile(`^([bdfghjklmnprstvz][aeiou]){3}[0-9]{2}$`)),
+					resource.TestCheckResourceAttr("random_password.pronounceable", "entropy_bits", "25.6"),
+				),
+			},
+			{
+				Config: `resource "random_password" "pronounceable" {
+							length      = 8
+							min_numeric = 2
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_password.pronounceable", "result", &result2),
+					testCheckAttributeValuesDiffer(&result1, &result2),
+				),
+			},
+			{
+				Config: `resource "random_password" "pronounceable" {
+							length      = 8
+							min_numeric = 2
+							mode        = "random"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_password.pronounceable", "result", &result1),
+					testCheckAttributeValuesEqual(&result1, &result2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_Pronounceable_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "pronounceable" {
+							length          = 8
+							mode            = "pronounceable"
+							exclude_similar = true
+						}`,
+				ExpectError: regexp.MustCompile(`exclude_similar cannot be set when mode is pronounceable`),
+			},
+			{
+				Config: `resource "random_password" "pronounceable" {
+							length = 8
+							mode   = "spoken"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute mode Value must be one of`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_ProviderDefaults(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "random" {
+							defaults {
+								password {
+									length           = 20
+									override_special = "!#@"
+									min_special      = 2
+									numeric          = false
+								}
+							}
+						}
+
+						resource "random_password" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("random_password.test", "result", testCheckLen(20)),
+					resource.TestCheckResourceAttr("random_password.test", "length", "20"),
+					resource.TestCheckResourceAttr("random_password.test", "override_special", "!#@"),
+					resource.TestCheckResourceAttr("random_password.test", "min_special", "2"),
+					resource.TestCheckResourceAttr("random_password.test", "number", "false"),
+					resource.TestCheckResourceAttr("random_password.test", "numeric", "false"),
+					resource.TestMatchResourceAttr("random_password.test", "result", regexp.MustCompile(`([!#@].*){2,}`)),
+					resource.TestMatchResourceAttr("random_password.test", "result", regexp.MustCompile(`^[^0-9]+$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_ProviderDefaults_ResourceOverride(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "random" {
+							defaults {
+								password {
+									length  = 20
+									special = false
+								}
+							}
+						}
+
+						resource "random_password" "test" {
+							length  = 12
+							special = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("random_password.test", "result", testCheckLen(12)),
+					resource.TestCheckResourceAttr("random_password.test", "special", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_ReproducibleSeed(t *testing.T) {
+	var result1, result2 string
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "random" {
+					reproducible_seed = "test"
+				}
+
+				resource "random_password" "first" {
+					length = 20
+					keepers = {
+						name = "first"
+					}
+				}
+
+				resource "random_password" "second" {
+					length = 20
+					keepers = {
+						name = "second"
+					}
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("random_password.first", "result", testCheckLen(20)),
+					testExtractResourceAttr("random_password.first", "result", &result1),
+					testExtractResourceAttr("random_password.second", "result", &result2),
+					testCheckAttributeValuesDiffer(&result1, &result2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_ReproducibleSeed_Errors(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "random" {
+					reproducible_seed = "test"
+				}
+
+				resource "random_password" "test" {
+					length = 20
+				}`,
+				ExpectError: regexp.MustCompile(`Set keepers to values which identify this resource`),
+			},
+			{
+				Config: `provider "random" {
+					reproducible_seed = "test"
+				}
+
+				resource "random_password" "test" {
+					count  = 2
+					length = 20
+					keepers = {
+						name = "test"
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`another random_password resource with an identical configuration`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_ProviderDefaults_Keep(t *testing.T) {
+	var result1, result2 string
+
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				Config: `provider "random" {
+							defaults {
+								password {
+									length  = 20
+									special = false
+								}
+							}
+						}
+
+						resource "random_password" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_password.test", "result", &result1),
+				),
+			},
+			{
+				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				Config: `provider "random" {
+							defaults {
+								password {
+									length  = 20
+									special = false
+								}
+							}
+						}
+
+						resource "random_password" "test" {}`,
+				PlanOnly: true,
+			},
+			{
+				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				Config: `provider "random" {
+							defaults {
+								password {
+									length  = 20
+									special = true
+								}
+							}
+						}
+
+						resource "random_password" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_password.test", "result", &result2),
+					testCheckAttributeValuesDiffer(&result1, &result2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_ProviderDefaults_MissingLength(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      `resource "random_password" "test" {}`,
+				ExpectError: regexp.MustCompile(`.*The length attribute must be set either in the resource configuration or\nin the provider defaults block`),
+			},
+		},
+	})
+}
+
 // TestAccResourcePassword_UpgradeFromVersion2_2_1 verifies behaviour when upgrading state from schema V0 to V3.
 func TestAccResourcePassword_UpgradeFromVersion2_2_1(t *testing.T) {
 	resource.Test(t, resource.TestCase{
@@ -1154,20 +1655,39 @@ func TestUpgradePasswordStateV0toV3(t *testing.T) {
 	upgradePasswordStateV0toV3(context.Background(), req, resp)
 
 	expected := passwordModelV3{
-		ID:              types.String{Value: "none"},
-		Keepers:         types.Map{Null: true, ElemType: types.StringType},
-		Length:          types.Int64{Value: 16},
-		Special:         types.Bool{Value: true},
-		Upper:           types.Bool{Value: true},
-		Lower:           types.Bool{Value: true},
-		Number:          types.Bool{Value: true},
-		Numeric:         types.Bool{Value: true},
-		MinNumeric:      types.Int64{Value: 0},
-		MinUpper:        types.Int64{Value: 0},
-		MinLower:        types.Int64{Value: 0},
-		MinSpecial:      types.Int64{Value: 0},
-		OverrideSpecial: types.String{Value: "!#$%\u0026*()-_=+[]{}\u003c\u003e:?"},
-		Result:          types.String{Value: "DZy_3*tnonj%Q%Yx"},
+		ExcludeCharacters:  types.String{Null: true},
+		ExcludeSimilar:     types.Bool{Null: true},
+		Mode:               types.String{Null: true},
+		Policy:             types.String{Null: true},
+		MinEntropyBits:     types.Float64{Null: true},
+		EntropyBits:        types.Float64{Null: true},
+		ByteLength:         types.Int64{Null: true},
+		Hashes:             types.Object{Null: true, AttrTypes: passwordHashesAttrTypes},
+		BcryptCost:         types.Int64{Null: true},
+		HtpasswdUsername:   types.String{Null: true},
+		HtpasswdFormat:     types.String{Null: true},
+		HtpasswdLine:       types.String{Null: true},
+		StoreResult:        types.Bool{Null: true},
+		RotationPeriod:     types.String{Null: true},
+		RotateAfter:        types.String{Null: true},
+		CreatedAt:          types.String{Null: true},
+		ExpiresAt:          types.String{Null: true},
+		PreviousResult:     types.String{Null: true},
+		PreviousBcryptHash: types.String{Null: true},
+		ID:                 types.String{Value: "none"},
+		Keepers:            types.Map{Null: true, ElemType: types.StringType},
+		Length:             types.Int64{Value: 16},
+		Special:            types.Bool{Value: true},
+		Upper:              types.Bool{Value: true},
+		Lower:              types.Bool{Value: true},
+		Number:             types.Bool{Value: true},
+		Numeric:            types.Bool{Value: true},
+		MinNumeric:         types.Int64{Value: 0},
+		MinUpper:           types.Int64{Value: 0},
+		MinLower:           types.Int64{Value: 0},
+		MinSpecial:         types.Int64{Value: 0},
+		OverrideSpecial:    types.String{Value: "!#$%\u0026*()-_=+[]{}\u003c\u003e:?"},
+		Result:             types.String{Value: "DZy_3*tnonj%Q%Yx"},
 	}
 
 	actual := passwordModelV3{}
@@ -1224,20 +1744,39 @@ func TestUpgradePasswordStateV0toV3_NullValues(t *testing.T) {
 	upgradePasswordStateV0toV3(context.Background(), req, resp)
 
 	expected := passwordModelV3{
-		ID:              types.String{Value: "none"},
-		Keepers:         types.Map{Null: true, ElemType: types.StringType},
-		Length:          types.Int64{Value: 16},
-		Special:         types.Bool{Value: true},
-		Upper:           types.Bool{Value: true},
-		Lower:           types.Bool{Value: true},
-		Number:          types.Bool{Value: true},
-		Numeric:         types.Bool{Value: true},
-		MinNumeric:      types.Int64{Value: 0},
-		MinUpper:        types.Int64{Value: 0},
-		MinLower:        types.Int64{Value: 0},
-		MinSpecial:      types.Int64{Value: 0},
-		OverrideSpecial: types.String{Null: true},
-		Result:          types.String{Value: "DZy_3*tnonj%Q%Yx"},
+		ExcludeCharacters:  types.String{Null: true},
+		ExcludeSimilar:     types.Bool{Null: true},
+		Mode:               types.String{Null: true},
+		Policy:             types.String{Null: true},
+		MinEntropyBits:     types.Float64{Null: true},
+		EntropyBits:        types.Float64{Null: true},
+		ByteLength:         types.Int64{Null: true},
+		Hashes:             types.Object{Null: true, AttrTypes: passwordHashesAttrTypes},
+		BcryptCost:         types.Int64{Null: true},
+		HtpasswdUsername:   types.String{Null: true},
+		HtpasswdFormat:     types.String{Null: true},
+		HtpasswdLine:       types.String{Null: true},
+		StoreResult:        types.Bool{Null: true},
+		RotationPeriod:     types.String{Null: true},
+		RotateAfter:        types.String{Null: true},
+		CreatedAt:          types.String{Null: true},
+		ExpiresAt:          types.String{Null: true},
+		PreviousResult:     types.String{Null: true},
+		PreviousBcryptHash: types.String{Null: true},
+		ID:                 types.String{Value: "none"},
+		Keepers:            types.Map{Null: true, ElemType: types.StringType},
+		Length:             types.Int64{Value: 16},
+		Special:            types.Bool{Value: true},
+		Upper:              types.Bool{Value: true},
+		Lower:              types.Bool{Value: true},
+		Number:             types.Bool{Value: true},
+		Numeric:            types.Bool{Value: true},
+		MinNumeric:         types.Int64{Value: 0},
+		MinUpper:           types.Int64{Value: 0},
+		MinLower:           types.Int64{Value: 0},
+		MinSpecial:         types.Int64{Value: 0},
+		OverrideSpecial:    types.String{Null: true},
+		Result:             types.String{Value: "DZy_3*tnonj%Q%Yx"},
 	}
 
 	actual := passwordModelV3{}
@@ -1295,21 +1834,40 @@ func TestUpgradePasswordStateV1toV3(t *testing.T) {
 	upgradePasswordStateV1toV3(context.Background(), req, resp)
 
 	expected := passwordModelV3{
-		ID:              types.String{Value: "none"},
-		Keepers:         types.Map{Null: true, ElemType: types.StringType},
-		Length:          types.Int64{Value: 16},
-		Special:         types.Bool{Value: true},
-		Upper:           types.Bool{Value: true},
-		Lower:           types.Bool{Value: true},
-		Number:          types.Bool{Value: true},
-		Numeric:         types.Bool{Value: true},
-		MinNumeric:      types.Int64{Value: 0},
-		MinUpper:        types.Int64{Value: 0},
-		MinLower:        types.Int64{Value: 0},
-		MinSpecial:      types.Int64{Value: 0},
-		OverrideSpecial: types.String{Value: "!#$%\u0026*()-_=+[]{}\u003c\u003e:?"},
-		BcryptHash:      types.String{Value: "bcrypt_hash"},
-		Result:          types.String{Value: "DZy_3*tnonj%Q%Yx"},
+		ExcludeCharacters:  types.String{Null: true},
+		ExcludeSimilar:     types.Bool{Null: true},
+		Mode:               types.String{Null: true},
+		Policy:             types.String{Null: true},
+		MinEntropyBits:     types.Float64{Null: true},
+		EntropyBits:        types.Float64{Null: true},
+		ByteLength:         types.Int64{Null: true},
+		Hashes:             types.Object{Null: true, AttrTypes: passwordHashesAttrTypes},
+		BcryptCost:         types.Int64{Null: true},
+		HtpasswdUsername:   types.String{Null: true},
+		HtpasswdFormat:     types.String{Null: true},
+		HtpasswdLine:       types.String{Null: true},
+		StoreResult:        types.Bool{Null: true},
+		RotationPeriod:     types.String{Null: true},
+		RotateAfter:        types.String{Null: true},
+		CreatedAt:          types.String{Null: true},
+		ExpiresAt:          types.String{Null: true},
+		PreviousResult:     types.String{Null: true},
+		PreviousBcryptHash: types.String{Null: true},
+		ID:                 types.String{Value: "none"},
+		Keepers:            types.Map{Null: true, ElemType: types.StringType},
+		Length:             types.Int64{Value: 16},
+		Special:            types.Bool{Value: true},
+		Upper:              types.Bool{Value: true},
+		Lower:              types.Bool{Value: true},
+		Number:             types.Bool{Value: true},
+		Numeric:            types.Bool{Value: true},
+		MinNumeric:         types.Int64{Value: 0},
+		MinUpper:           types.Int64{Value: 0},
+		MinLower:           types.Int64{Value: 0},
+		MinSpecial:         types.Int64{Value: 0},
+		OverrideSpecial:    types.String{Value: "!#$%\u0026*()-_=+[]{}\u003c\u003e:?"},
+		BcryptHash:         types.String{Value: "bcrypt_hash"},
+		Result:             types.String{Value: "DZy_3*tnonj%Q%Yx"},
 	}
 
 	actual := passwordModelV3{}
@@ -1359,21 +1917,40 @@ func TestUpgradePasswordStateV1toV3_NullValues(t *testing.T) {
 	upgradePasswordStateV1toV3(context.Background(), req, resp)
 
 	expected := passwordModelV3{
-		ID:              types.String{Value: "none"},
-		Keepers:         types.Map{Null: true, ElemType: types.StringType},
-		Length:          types.Int64{Value: 16},
-		Special:         types.Bool{Value: true},
-		Upper:           types.Bool{Value: true},
-		Lower:           types.Bool{Value: true},
-		Number:          types.Bool{Value: true},
-		Numeric:         types.Bool{Value: true},
-		MinNumeric:      types.Int64{Value: 0},
-		MinUpper:        types.Int64{Value: 0},
-		MinLower:        types.Int64{Value: 0},
-		MinSpecial:      types.Int64{Value: 0},
-		OverrideSpecial: types.String{Null: true},
-		BcryptHash:      types.String{Value: "bcrypt_hash"},
-		Result:          types.String{Value: "DZy_3*tnonj%Q%Yx"},
+		ExcludeCharacters:  types.String{Null: true},
+		ExcludeSimilar:     types.Bool{Null: true},
+		Mode:               types.String{Null: true},
+		Policy:             types.String{Null: true},
+		MinEntropyBits:     types.Float64{Null: true},
+		EntropyBits:        types.Float64{Null: true},
+		ByteLength:         types.Int64{Null: true},
+		Hashes:             types.Object{Null: true, AttrTypes: passwordHashesAttrTypes},
+		BcryptCost:         types.Int64{Null: true},
+		HtpasswdUsername:   types.String{Null: true},
+		HtpasswdFormat:     types.String{Null: true},
+		HtpasswdLine:       types.String{Null: true},
+		StoreResult:        types.Bool{Null: true},
+		RotationPeriod:     types.String{Null: true},
+		RotateAfter:        types.String{Null: true},
+		CreatedAt:          types.String{Null: true},
+		ExpiresAt:          types.String{Null: true},
+		PreviousResult:     types.String{Null: true},
+		PreviousBcryptHash: types.String{Null: true},
+		ID:                 types.String{Value: "none"},
+		Keepers:            types.Map{Null: true, ElemType: types.StringType},
+		Length:             types.Int64{Value: 16},
+		Special:            types.Bool{Value: true},
+		Upper:              types.Bool{Value: true},
+		Lower:              types.Bool{Value: true},
+		Number:             types.Bool{Value: true},
+		Numeric:            types.Bool{Value: true},
+		MinNumeric:         types.Int64{Value: 0},
+		MinUpper:           types.Int64{Value: 0},
+		MinLower:           types.Int64{Value: 0},
+		MinSpecial:         types.Int64{Value: 0},
+		OverrideSpecial:    types.String{Null: true},
+		BcryptHash:         types.String{Value: "bcrypt_hash"},
+		Result:             types.String{Value: "DZy_3*tnonj%Q%Yx"},
 	}
 
 	actual := passwordModelV3{}
@@ -1387,6 +1964,52 @@ func TestUpgradePasswordStateV1toV3_NullValues(t *testing.T) {
 	}
 }
 
+// characterClassListType is the type of the character_class block list in version 3 of the
+// random_password and random_string schemas.
+var characterClassListType = tftypes.List{
+	ElementType: tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"characters": tftypes.String,
+			"max":        tftypes.Number,
+			"min":        tftypes.Number,
+			"name":       tftypes.String,
+		},
+	},
+}
+
+// hashOptionsListType is the type of the hash_options block list in version 3 of the
+// random_password schema.
+var hashOptionsListType = tftypes.List{
+	ElementType: tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"algorithms":                         tftypes.Set{ElementType: tftypes.String},
+			"argon2id_iterations":                tftypes.Number,
+			"argon2id_memory":                    tftypes.Number,
+			"argon2id_parallelism":               tftypes.Number,
+			"pbkdf2_sha256_iterations":           tftypes.Number,
+			"postgresql_scram_sha256_iterations": tftypes.Number,
+			"scrypt_block_size":                  tftypes.Number,
+			"scrypt_cost":                        tftypes.Number,
+			"scrypt_parallelism":                 tftypes.Number,
+			"sha512_crypt_rounds":                tftypes.Number,
+		},
+	},
+}
+
+// passwordHashesObjectType is the type of the hashes attribute in version 3 of the
+// random_password schema.
+var passwordHashesObjectType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"argon2id":                    tftypes.String,
+		"mysql_caching_sha2_password": tftypes.String,
+		"mysql_native_password":       tftypes.String,
+		"pbkdf2_sha256":               tftypes.String,
+		"postgresql_scram_sha256":     tftypes.String,
+		"scrypt":                      tftypes.String,
+		"sha512_crypt":                tftypes.String,
+	},
+}
+
 func TestUpgradePasswordStateV2toV3(t *testing.T) {
 	t.Parallel()
 
@@ -1439,40 +2062,82 @@ func TestUpgradePasswordStateV2toV3(t *testing.T) {
 				State: tfsdk.State{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
-							"bcrypt_hash":      tftypes.String,
-							"id":               tftypes.String,
-							"keepers":          tftypes.Map{ElementType: tftypes.String},
-							"length":           tftypes.Number,
-							"lower":            tftypes.Bool,
-							"min_lower":        tftypes.Number,
-							"min_numeric":      tftypes.Number,
-							"min_special":      tftypes.Number,
-							"min_upper":        tftypes.Number,
-							"number":           tftypes.Bool,
-							"numeric":          tftypes.Bool,
-							"override_special": tftypes.String,
-							"result":           tftypes.String,
-							"special":          tftypes.Bool,
-							"upper":            tftypes.Bool,
+							"bcrypt_cost":          tftypes.Number,
+							"bcrypt_hash":          tftypes.String,
+							"byte_length":          tftypes.Number,
+							"character_class":      characterClassListType,
+							"hash_options":         hashOptionsListType,
+							"hashes":               passwordHashesObjectType,
+							"htpasswd_format":      tftypes.String,
+							"htpasswd_line":        tftypes.String,
+							"htpasswd_username":    tftypes.String,
+							"store_result":         tftypes.Bool,
+							"created_at":           tftypes.String,
+							"expires_at":           tftypes.String,
+							"rotate_after":         tftypes.String,
+							"rotation_period":      tftypes.String,
+							"previous_bcrypt_hash": tftypes.String,
+							"previous_result":      tftypes.String,
+							"entropy_bits":         tftypes.Number,
+							"exclude_characters":   tftypes.String,
+							"exclude_similar":      tftypes.Bool,
+							"mode":                 tftypes.String,
+							"id":                   tftypes.String,
+							"keepers":              tftypes.Map{ElementType: tftypes.String},
+							"length":               tftypes.Number,
+							"lower":                tftypes.Bool,
+							"min_lower":            tftypes.Number,
+							"min_numeric":          tftypes.Number,
+							"min_special":          tftypes.Number,
+							"min_entropy_bits":     tftypes.Number,
+							"min_upper":            tftypes.Number,
+							"number":               tftypes.Bool,
+							"numeric":              tftypes.Bool,
+							"override_special":     tftypes.String,
+							"policy":               tftypes.String,
+							"result":               tftypes.String,
+							"special":              tftypes.Bool,
+							"upper":                tftypes.Bool,
 						},
 					}, map[string]tftypes.Value{
 						// The difference checking should compare this actual
 						// value since it should not be updated.
-						"bcrypt_hash":      tftypes.NewValue(tftypes.String, "$2a$10$d9zhEkVg.O1jZ6fEIMRlRuu/vMa0/4UIzeK5joaTBhZJlYiIPhWWa"),
-						"id":               tftypes.NewValue(tftypes.String, "none"),
-						"keepers":          tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
-						"length":           tftypes.NewValue(tftypes.Number, 20),
-						"lower":            tftypes.NewValue(tftypes.Bool, true),
-						"min_lower":        tftypes.NewValue(tftypes.Number, 0),
-						"min_numeric":      tftypes.NewValue(tftypes.Number, 0),
-						"min_special":      tftypes.NewValue(tftypes.Number, 0),
-						"min_upper":        tftypes.NewValue(tftypes.Number, 0),
-						"number":           tftypes.NewValue(tftypes.Bool, true),
-						"numeric":          tftypes.NewValue(tftypes.Bool, true),
-						"override_special": tftypes.NewValue(tftypes.String, ""),
-						"result":           tftypes.NewValue(tftypes.String, "n:um[a9kO&x!L=9og[EM"),
-						"special":          tftypes.NewValue(tftypes.Bool, true),
-						"upper":            tftypes.NewValue(tftypes.Bool, true),
+						"bcrypt_cost":          tftypes.NewValue(tftypes.Number, nil),
+						"bcrypt_hash":          tftypes.NewValue(tftypes.String, "$2a$10$d9zhEkVg.O1jZ6fEIMRlRuu/vMa0/4UIzeK5joaTBhZJlYiIPhWWa"),
+						"byte_length":          tftypes.NewValue(tftypes.Number, nil),
+						"character_class":      tftypes.NewValue(characterClassListType, nil),
+						"hash_options":         tftypes.NewValue(hashOptionsListType, nil),
+						"hashes":               tftypes.NewValue(passwordHashesObjectType, nil),
+						"htpasswd_format":      tftypes.NewValue(tftypes.String, nil),
+						"htpasswd_line":        tftypes.NewValue(tftypes.String, nil),
+						"htpasswd_username":    tftypes.NewValue(tftypes.String, nil),
+						"store_result":         tftypes.NewValue(tftypes.Bool, nil),
+						"created_at":           tftypes.NewValue(tftypes.String, nil),
+						"expires_at":           tftypes.NewValue(tftypes.String, nil),
+						"rotate_after":         tftypes.NewValue(tftypes.String, nil),
+						"rotation_period":      tftypes.NewValue(tftypes.String, nil),
+						"previous_bcrypt_hash": tftypes.NewValue(tftypes.String, nil),
+						"previous_result":      tftypes.NewValue(tftypes.String, nil),
+						"entropy_bits":         tftypes.NewValue(tftypes.Number, nil),
+						"exclude_characters":   tftypes.NewValue(tftypes.String, nil),
+						"exclude_similar":      tftypes.NewValue(tftypes.Bool, nil),
+						"mode":                 tftypes.NewValue(tftypes.String, nil),
+						"id":                   tftypes.NewValue(tftypes.String, "none"),
+						"keepers":              tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+						"length":               tftypes.NewValue(tftypes.Number, 20),
+						"lower":                tftypes.NewValue(tftypes.Bool, true),
+						"min_lower":            tftypes.NewValue(tftypes.Number, 0),
+						"min_numeric":          tftypes.NewValue(tftypes.Number, 0),
+						"min_special":          tftypes.NewValue(tftypes.Number, 0),
+						"min_entropy_bits":     tftypes.NewValue(tftypes.Number, nil),
+						"min_upper":            tftypes.NewValue(tftypes.Number, 0),
+						"number":               tftypes.NewValue(tftypes.Bool, true),
+						"numeric":              tftypes.NewValue(tftypes.Bool, true),
+						"override_special":     tftypes.NewValue(tftypes.String, ""),
+						"policy":               tftypes.NewValue(tftypes.String, nil),
+						"result":               tftypes.NewValue(tftypes.String, "n:um[a9kO&x!L=9og[EM"),
+						"special":              tftypes.NewValue(tftypes.Bool, true),
+						"upper":                tftypes.NewValue(tftypes.Bool, true),
 					}),
 					Schema: passwordSchemaV3(),
 				},
@@ -1523,40 +2188,82 @@ func TestUpgradePasswordStateV2toV3(t *testing.T) {
 				State: tfsdk.State{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
-							"bcrypt_hash":      tftypes.String,
-							"id":               tftypes.String,
-							"keepers":          tftypes.Map{ElementType: tftypes.String},
-							"length":           tftypes.Number,
-							"lower":            tftypes.Bool,
-							"min_lower":        tftypes.Number,
-							"min_numeric":      tftypes.Number,
-							"min_special":      tftypes.Number,
-							"min_upper":        tftypes.Number,
-							"number":           tftypes.Bool,
-							"numeric":          tftypes.Bool,
-							"override_special": tftypes.String,
-							"result":           tftypes.String,
-							"special":          tftypes.Bool,
-							"upper":            tftypes.Bool,
+							"bcrypt_cost":          tftypes.Number,
+							"bcrypt_hash":          tftypes.String,
+							"byte_length":          tftypes.Number,
+							"character_class":      characterClassListType,
+							"hash_options":         hashOptionsListType,
+							"hashes":               passwordHashesObjectType,
+							"htpasswd_format":      tftypes.String,
+							"htpasswd_line":        tftypes.String,
+							"htpasswd_username":    tftypes.String,
+							"store_result":         tftypes.Bool,
+							"created_at":           tftypes.String,
+							"expires_at":           tftypes.String,
+							"rotate_after":         tftypes.String,
+							"rotation_period":      tftypes.String,
+							"previous_bcrypt_hash": tftypes.String,
+							"previous_result":      tftypes.String,
+							"entropy_bits":         tftypes.Number,
+							"exclude_characters":   tftypes.String,
+							"exclude_similar":      tftypes.Bool,
+							"mode":                 tftypes.String,
+							"id":                   tftypes.String,
+							"keepers":              tftypes.Map{ElementType: tftypes.String},
+							"length":               tftypes.Number,
+							"lower":                tftypes.Bool,
+							"min_lower":            tftypes.Number,
+							"min_numeric":          tftypes.Number,
+							"min_special":          tftypes.Number,
+							"min_entropy_bits":     tftypes.Number,
+							"min_upper":            tftypes.Number,
+							"number":               tftypes.Bool,
+							"numeric":              tftypes.Bool,
+							"override_special":     tftypes.String,
+							"policy":               tftypes.String,
+							"result":               tftypes.String,
+							"special":              tftypes.Bool,
+							"upper":                tftypes.Bool,
 						},
 					}, map[string]tftypes.Value{
 						// bcrypt_hash is randomly generated, so the difference checking
 						// will ignore this value.
-						"bcrypt_hash":      tftypes.NewValue(tftypes.String, nil),
-						"id":               tftypes.NewValue(tftypes.String, "none"),
-						"keepers":          tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
-						"length":           tftypes.NewValue(tftypes.Number, 20),
-						"lower":            tftypes.NewValue(tftypes.Bool, true),
-						"min_lower":        tftypes.NewValue(tftypes.Number, 0),
-						"min_numeric":      tftypes.NewValue(tftypes.Number, 0),
-						"min_special":      tftypes.NewValue(tftypes.Number, 0),
-						"min_upper":        tftypes.NewValue(tftypes.Number, 0),
-						"number":           tftypes.NewValue(tftypes.Bool, true),
-						"numeric":          tftypes.NewValue(tftypes.Bool, true),
-						"override_special": tftypes.NewValue(tftypes.String, ""),
-						"result":           tftypes.NewValue(tftypes.String, "$7r>NiN4Z%uAxpU]:DuB"),
-						"special":          tftypes.NewValue(tftypes.Bool, true),
-						"upper":            tftypes.NewValue(tftypes.Bool, true),
+						"bcrypt_cost":          tftypes.NewValue(tftypes.Number, nil),
+						"bcrypt_hash":          tftypes.NewValue(tftypes.String, nil),
+						"byte_length":          tftypes.NewValue(tftypes.Number, nil),
+						"character_class":      tftypes.NewValue(characterClassListType, nil),
+						"hash_options":         tftypes.NewValue(hashOptionsListType, nil),
+						"hashes":               tftypes.NewValue(passwordHashesObjectType, nil),
+						"htpasswd_format":      tftypes.NewValue(tftypes.String, nil),
+						"htpasswd_line":        tftypes.NewValue(tftypes.String, nil),
+						"htpasswd_username":    tftypes.NewValue(tftypes.String, nil),
+						"store_result":         tftypes.NewValue(tftypes.Bool, nil),
+						"created_at":           tftypes.NewValue(tftypes.String, nil),
+						"expires_at":           tftypes.NewValue(tftypes.String, nil),
+						"rotate_after":         tftypes.NewValue(tftypes.String, nil),
+						"rotation_period":      tftypes.NewValue(tftypes.String, nil),
+						"previous_bcrypt_hash": tftypes.NewValue(tftypes.String, nil),
+						"previous_result":      tftypes.NewValue(tftypes.String, nil),
+						"entropy_bits":         tftypes.NewValue(tftypes.Number, nil),
+						"exclude_characters":   tftypes.NewValue(tftypes.String, nil),
+						"exclude_similar":      tftypes.NewValue(tftypes.Bool, nil),
+						"mode":                 tftypes.NewValue(tftypes.String, nil),
+						"id":                   tftypes.NewValue(tftypes.String, "none"),
+						"keepers":              tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+						"length":               tftypes.NewValue(tftypes.Number, 20),
+						"lower":                tftypes.NewValue(tftypes.Bool, true),
+						"min_lower":            tftypes.NewValue(tftypes.Number, 0),
+						"min_numeric":          tftypes.NewValue(tftypes.Number, 0),
+						"min_special":          tftypes.NewValue(tftypes.Number, 0),
+						"min_entropy_bits":     tftypes.NewValue(tftypes.Number, nil),
+						"min_upper":            tftypes.NewValue(tftypes.Number, 0),
+						"number":               tftypes.NewValue(tftypes.Bool, true),
+						"numeric":              tftypes.NewValue(tftypes.Bool, true),
+						"override_special":     tftypes.NewValue(tftypes.String, ""),
+						"policy":               tftypes.NewValue(tftypes.String, nil),
+						"result":               tftypes.NewValue(tftypes.String, "$7r>NiN4Z%uAxpU]:DuB"),
+						"special":              tftypes.NewValue(tftypes.Bool, true),
+						"upper":                tftypes.NewValue(tftypes.Bool, true),
 					}),
 					Schema: passwordSchemaV3(),
 				},
@@ -1607,40 +2314,82 @@ func TestUpgradePasswordStateV2toV3(t *testing.T) {
 				State: tfsdk.State{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
-							"bcrypt_hash":      tftypes.String,
-							"id":               tftypes.String,
-							"keepers":          tftypes.Map{ElementType: tftypes.String},
-							"length":           tftypes.Number,
-							"lower":            tftypes.Bool,
-							"min_lower":        tftypes.Number,
-							"min_numeric":      tftypes.Number,
-							"min_special":      tftypes.Number,
-							"min_upper":        tftypes.Number,
-							"number":           tftypes.Bool,
-							"numeric":          tftypes.Bool,
-							"override_special": tftypes.String,
-							"result":           tftypes.String,
-							"special":          tftypes.Bool,
-							"upper":            tftypes.Bool,
+							"bcrypt_cost":          tftypes.Number,
+							"bcrypt_hash":          tftypes.String,
+							"byte_length":          tftypes.Number,
+							"character_class":      characterClassListType,
+							"hash_options":         hashOptionsListType,
+							"hashes":               passwordHashesObjectType,
+							"htpasswd_format":      tftypes.String,
+							"htpasswd_line":        tftypes.String,
+							"htpasswd_username":    tftypes.String,
+							"store_result":         tftypes.Bool,
+							"created_at":           tftypes.String,
+							"expires_at":           tftypes.String,
+							"rotate_after":         tftypes.String,
+							"rotation_period":      tftypes.String,
+							"previous_bcrypt_hash": tftypes.String,
+							"previous_result":      tftypes.String,
+							"entropy_bits":         tftypes.Number,
+							"exclude_characters":   tftypes.String,
+							"exclude_similar":      tftypes.Bool,
+							"mode":                 tftypes.String,
+							"id":                   tftypes.String,
+							"keepers":              tftypes.Map{ElementType: tftypes.String},
+							"length":               tftypes.Number,
+							"lower":                tftypes.Bool,
+							"min_lower":            tftypes.Number,
+							"min_numeric":          tftypes.Number,
+							"min_special":          tftypes.Number,
+							"min_entropy_bits":     tftypes.Number,
+							"min_upper":            tftypes.Number,
+							"number":               tftypes.Bool,
+							"numeric":              tftypes.Bool,
+							"override_special":     tftypes.String,
+							"policy":               tftypes.String,
+							"result":               tftypes.String,
+							"special":              tftypes.Bool,
+							"upper":                tftypes.Bool,
 						},
 					}, map[string]tftypes.Value{
 						// The difference checking should compare this actual
 						// value since it should not be updated.
-						"bcrypt_hash":      tftypes.NewValue(tftypes.String, "$2a$10$d9zhEkVg.O1jZ6fEIMRlRuu/vMa0/4UIzeK5joaTBhZJlYiIPhWWa"),
-						"id":               tftypes.NewValue(tftypes.String, "none"),
-						"keepers":          tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
-						"length":           tftypes.NewValue(tftypes.Number, 20),
-						"lower":            tftypes.NewValue(tftypes.Bool, true),
-						"min_lower":        tftypes.NewValue(tftypes.Number, 0),
-						"min_numeric":      tftypes.NewValue(tftypes.Number, 0),
-						"min_special":      tftypes.NewValue(tftypes.Number, 0),
-						"min_upper":        tftypes.NewValue(tftypes.Number, 0),
-						"number":           tftypes.NewValue(tftypes.Bool, true),
-						"numeric":          tftypes.NewValue(tftypes.Bool, true),
-						"override_special": tftypes.NewValue(tftypes.String, ""),
-						"result":           tftypes.NewValue(tftypes.String, "n:um[a9kO&x!L=9og[EM"),
-						"special":          tftypes.NewValue(tftypes.Bool, true),
-						"upper":            tftypes.NewValue(tftypes.Bool, true),
+						"bcrypt_cost":          tftypes.NewValue(tftypes.Number, nil),
+						"bcrypt_hash":          tftypes.NewValue(tftypes.String, "$2a$10$d9zhEkVg.O1jZ6fEIMRlRuu/vMa0/4UIzeK5joaTBhZJlYiIPhWWa"),
+						"byte_length":          tftypes.NewValue(tftypes.Number, nil),
+						"character_class":      tftypes.NewValue(characterClassListType, nil),
+						"hash_options":         tftypes.NewValue(hashOptionsListType, nil),
+						"hashes":               tftypes.NewValue(passwordHashesObjectType, nil),
+						"htpasswd_format":      tftypes.NewValue(tftypes.String, nil),
+						"htpasswd_line":        tftypes.NewValue(tftypes.String, nil),
+						"htpasswd_username":    tftypes.NewValue(tftypes.String, nil),
+						"store_result":         tftypes.NewValue(tftypes.Bool, nil),
+						"created_at":           tftypes.NewValue(tftypes.String, nil),
+						"expires_at":           tftypes.NewValue(tftypes.String, nil),
+						"rotate_after":         tftypes.NewValue(tftypes.String, nil),
+						"rotation_period":      tftypes.NewValue(tftypes.String, nil),
+						"previous_bcrypt_hash": tftypes.NewValue(tftypes.String, nil),
+						"previous_result":      tftypes.NewValue(tftypes.String, nil),
+						"entropy_bits":         tftypes.NewValue(tftypes.Number, nil),
+						"exclude_characters":   tftypes.NewValue(tftypes.String, nil),
+						"exclude_similar":      tftypes.NewValue(tftypes.Bool, nil),
+						"mode":                 tftypes.NewValue(tftypes.String, nil),
+						"id":                   tftypes.NewValue(tftypes.String, "none"),
+						"keepers":              tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+						"length":               tftypes.NewValue(tftypes.Number, 20),
+						"lower":                tftypes.NewValue(tftypes.Bool, true),
+						"min_lower":            tftypes.NewValue(tftypes.Number, 0),
+						"min_numeric":          tftypes.NewValue(tftypes.Number, 0),
+						"min_special":          tftypes.NewValue(tftypes.Number, 0),
+						"min_entropy_bits":     tftypes.NewValue(tftypes.Number, nil),
+						"min_upper":            tftypes.NewValue(tftypes.Number, 0),
+						"number":               tftypes.NewValue(tftypes.Bool, true),
+						"numeric":              tftypes.NewValue(tftypes.Bool, true),
+						"override_special":     tftypes.NewValue(tftypes.String, ""),
+						"policy":               tftypes.NewValue(tftypes.String, nil),
+						"result":               tftypes.NewValue(tftypes.String, "n:um[a9kO&x!L=9og[EM"),
+						"special":              tftypes.NewValue(tftypes.Bool, true),
+						"upper":                tftypes.NewValue(tftypes.Bool, true),
 					}),
 					Schema: passwordSchemaV3(),
 				},
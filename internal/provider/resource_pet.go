@@ -1,26 +1,41 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
+	"unicode"
 
-	petname "github.com/dustinkirkland/golang-petname"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/terraform-providers/terraform-provider-random/internal/planmodifiers"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
 )
 
-var _ resource.Resource = (*petResource)(nil)
+var (
+	_ resource.Resource                = (*petResource)(nil)
+	_ resource.ResourceWithConfigure   = (*petResource)(nil)
+	_ resource.ResourceWithImportState = (*petResource)(nil)
+)
+
+// petSeparator matches the last run of characters other than letters and digits, followed
+// by the last word, in a pet name. The run is assumed to be the separator of an imported pet
+// name.
+var petSeparator = regexp.MustCompile(`[^\pL\pN]+[\pL\pN]*$`)
 
 func NewPetResource() resource.Resource {
 	return &petResource{}
 }
 
-type petResource struct{}
+type petResource struct {
+	providerData *providerData
+}
 
 func (r *petResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_pet"
@@ -84,12 +99,11 @@ func (r *petResource) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics
 	}, nil
 }
 
-func (r *petResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	// This is necessary to ensure each call to petname is properly randomised:
-	// the library uses `rand.Intn()` and does NOT seed `rand.Seed()` by default,
-	// so this call takes care of that.
-	petname.NonDeterministicMode()
+func (r *petResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.providerData = configureProviderData(req, resp)
+}
 
+func (r *petResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan petModelV0
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -102,7 +116,19 @@ func (r *petResource) Create(ctx context.Context, req resource.CreateRequest, re
 	separator := plan.Separator.Value
 	prefix := plan.Prefix.Value
 
-	pet := strings.ToLower(petname.Generate(int(length), separator))
+	source, diags := r.providerData.randSource(ctx, "random_pet", req.Config)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pet, err := random.CreatePetName(source, int(length), separator)
+	if err != nil {
+		resp.Diagnostics.Append(randError(err)...)
+		return
+	}
 
 	pn := petModelV0{
 		Keepers:   plan.Keepers,
@@ -148,6 +174,120 @@ func (r *petResource) Update(ctx context.Context, req resource.UpdateRequest, re
 func (r *petResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 }
 
+// ImportState sets the id of the resource to the imported pet name, which is either the name, or a JSON
+// object of the name, as id, with optional separator, prefix and keepers. The name is split into words,
+// from which length is derived, and an error is added if it could not have been generated.
+func (r *petResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var importID struct {
+		ID        string            `json:"id"`
+		Separator *string           `json:"separator"`
+		Prefix    *string           `json:"prefix"`
+		Keepers   map[string]string `json:"keepers"`
+	}
+
+	if strings.HasPrefix(req.ID, "{") {
+		decoder := json.NewDecoder(bytes.NewBufferString(req.ID))
+		decoder.DisallowUnknownFields()
+
+		if err := decoder.Decode(&importID); err != nil {
+			resp.Diagnostics.AddError(
+				"Import Random Pet Error",
+				"Invalid import usage: expecting {name} or a JSON object with id and optional separator, prefix "+
+					"and keepers.\n\n"+
+					fmt.Sprintf("Original Error: %s", err),
+			)
+			return
+		}
+	} else {
+		importID.ID = req.ID
+	}
+
+	words, separator, prefix, err := splitPetName(importID.ID, importID.Separator, importID.Prefix)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Import Random Pet Error",
+			fmt.Sprintf("The pet name %q could not have been generated by random_pet: %s.", importID.ID, err),
+		)
+		return
+	}
+
+	state := petModelV0{
+		ID:        types.String{Value: importID.ID},
+		Keepers:   importKeepers(importID.Keepers),
+		Length:    types.Int64{Value: int64(len(words))},
+		Prefix:    types.String{Null: prefix == "", Value: prefix},
+		Separator: types.String{Value: separator},
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// splitPetName returns the words, separator and prefix of a pet name. If separator is nil, it is
+// inferred from the last run of characters other than letters and digits, or is the default separator
+// if the name is a single word. If prefix is nil, it is inferred as the part of the name preceding the
+// longest run of words at the end of the name which random_pet could have generated. An error is
+// returned if the name does not round-trip, that is, if the words could not have been generated or the
+// prefix, separator and words would not produce the name.
+func splitPetName(name string, separator, prefix *string) ([]string, string, string, error) {
+	sep := "-"
+
+	if separator != nil {
+		sep = *separator
+	} else if match := petSeparator.FindString(name); match != "" {
+		sep = strings.TrimRightFunc(match, func(r rune) bool {
+			return unicode.IsLetter(r) || unicode.IsNumber(r)
+		})
+	}
+
+	if sep == "" {
+		return nil, "", "", fmt.Errorf("the words of a pet name with an empty separator cannot be determined")
+	}
+
+	rest := name
+	pfx := ""
+
+	if prefix != nil && *prefix != "" {
+		pfx = *prefix
+
+		if !strings.HasPrefix(name, pfx+sep) {
+			return nil, "", "", fmt.Errorf("the name does not start with the prefix %q and separator %q", pfx, sep)
+		}
+
+		rest = strings.TrimPrefix(name, pfx+sep)
+	}
+
+	words := strings.Split(rest, sep)
+
+	if prefix != nil {
+		if err := random.VerifyPetName(words); err != nil {
+			return nil, "", "", err
+		}
+
+		return words, sep, pfx, nil
+	}
+
+	// Each run of words at the end of the name is tried, longest first, and anything before the first
+	// which random_pet could have generated is the prefix. The last word alone is tried last, so if no
+	// run matches, the last word is not one of the pet names.
+	i := 0
+
+	for i < len(words)-1 && random.VerifyPetName(words[i:]) != nil {
+		i++
+	}
+
+	if err := random.VerifyPetName(words[i:]); err != nil {
+		return nil, "", "", err
+	}
+
+	pfx = strings.Join(words[:i], sep)
+
+	if pfx == "" && strings.HasPrefix(name, sep) {
+		return nil, "", "", fmt.Errorf("the name starts with the separator %q", sep)
+	}
+
+	return words[i:], sep, pfx, nil
+}
+
 type petModelV0 struct {
 	ID        types.String `tfsdk:"id"`
 	Keepers   types.Map    `tfsdk:"keepers"`
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccResourcePet(t *testing.T) {
@@ -775,6 +776,58 @@ func TestAccResourcePet_Separator(t *testing.T) {
 	})
 }
 
+func TestAccResourcePet_ReproducibleSeed(t *testing.T) {
+	var id1, id2 string
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "random" {
+					reproducible_seed = "test"
+				}
+
+				resource "random_pet" "first" {
+					keepers = {
+						name = "first"
+					}
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_pet.first", "id", &id1),
+				),
+			},
+			{
+				Config: `provider "random" {
+					reproducible_seed = "test"
+				}`,
+			},
+			{
+				Config: `provider "random" {
+					reproducible_seed = "test"
+				}
+
+				resource "random_pet" "first" {
+					keepers = {
+						name = "first"
+					}
+				}
+
+				resource "random_pet" "second" {
+					keepers = {
+						name = "second"
+					}
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_pet.first", "id", &id2),
+					testCheckAttributeValuesEqual(&id1, &id2),
+					testExtractResourceAttr("random_pet.second", "id", &id2),
+					testCheckAttributeValuesDiffer(&id1, &id2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourcePet_UpgradeFromVersion3_3_2(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		Steps: []resource.TestStep{
@@ -809,6 +862,216 @@ func TestAccResourcePet_UpgradeFromVersion3_3_2(t *testing.T) {
 	})
 }
 
+func TestAccResourcePet_Import(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "test" {
+							length    = 3
+							separator = "_"
+						}`,
+			},
+			{
+				ResourceName:      "random_pet.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourcePet_Import_Prefix(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "test" {
+							prefix = "web-server"
+						}`,
+			},
+			{
+				ResourceName: "random_pet.test",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["random_pet.test"]
+					if !ok {
+						return "", fmt.Errorf("not found: random_pet.test")
+					}
+
+					return fmt.Sprintf(`{"id": %q, "prefix": "web-server"}`, rs.Primary.ID), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourcePet_Import_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "test" {
+						}`,
+				ResourceName:  "random_pet.test",
+				ImportState:   true,
+				ImportStateId: "Happy-Cat",
+				ExpectError:   regexp.MustCompile(`could not have been generated by random_pet`),
+			},
+			{
+				Config: `resource "random_pet" "test" {
+						}`,
+				ResourceName:  "random_pet.test",
+				ImportState:   true,
+				ImportStateId: "happy-unicorns",
+				ExpectError:   regexp.MustCompile(`the\sword\s"unicorns"\sis\snot\sone\sof\sthe\spet\sname\snames`),
+			},
+			{
+				Config: `resource "random_pet" "test" {
+						}`,
+				ResourceName:  "random_pet.test",
+				ImportState:   true,
+				ImportStateId: `{"id": "web-happy-cat", "prefix": "api"}`,
+				ExpectError:   regexp.MustCompile(`does not start with the prefix`),
+			},
+		},
+	})
+}
+
+func TestSplitPetName(t *testing.T) {
+	t.Parallel()
+
+	stringPointer := func(s string) *string { return &s }
+
+	testCases := map[string]struct {
+		name              string
+		separator         *string
+		prefix            *string
+		expectedWords     []string
+		expectedSeparator string
+		expectedPrefix    string
+		expectedError     bool
+	}{
+		"default": {
+			name:              "happy-cat",
+			expectedWords:     []string{"happy", "cat"},
+			expectedSeparator: "-",
+		},
+		"single-word": {
+			name:              "cat",
+			expectedWords:     []string{"cat"},
+			expectedSeparator: "-",
+		},
+		"inferred-separator": {
+			name:              "really.happy.cat",
+			expectedWords:     []string{"really", "happy", "cat"},
+			expectedSeparator: ".",
+		},
+		"inferred-prefix": {
+			name:              "prod01_happy_cat",
+			expectedWords:     []string{"happy", "cat"},
+			expectedSeparator: "_",
+			expectedPrefix:    "prod01",
+		},
+		"prefix": {
+			name:              "web-server-happy-cat",
+			prefix:            stringPointer("web-server"),
+			expectedWords:     []string{"happy", "cat"},
+			expectedSeparator: "-",
+			expectedPrefix:    "web-server",
+		},
+		"inferred-prefix-words": {
+			name:              "happy-really-happy-cat",
+			expectedWords:     []string{"really", "happy", "cat"},
+			expectedSeparator: "-",
+			expectedPrefix:    "happy",
+		},
+		"empty-prefix": {
+			name:              "really-happy-cat",
+			prefix:            stringPointer(""),
+			expectedWords:     []string{"really", "happy", "cat"},
+			expectedSeparator: "-",
+		},
+		"separator": {
+			name:              "happy--cat",
+			separator:         stringPointer("--"),
+			expectedWords:     []string{"happy", "cat"},
+			expectedSeparator: "--",
+		},
+		"unknown-name": {
+			name:          "happy-unicorns",
+			expectedError: true,
+		},
+		"unknown-adjective": {
+			name:          "web-grumpy-cat",
+			prefix:        stringPointer("web"),
+			expectedError: true,
+		},
+		"unknown-adverb": {
+			name:          "web-cat-happy-cat",
+			prefix:        stringPointer("web"),
+			expectedError: true,
+		},
+		"upper-case": {
+			name:          "Happy-Cat",
+			expectedError: true,
+		},
+		"trailing-separator": {
+			name:          "happy-cat-",
+			expectedError: true,
+		},
+		"leading-separator": {
+			name:          "-happy-cat",
+			expectedError: true,
+		},
+		"prefix-mismatch": {
+			name:          "web-happy-cat",
+			prefix:        stringPointer("api"),
+			expectedError: true,
+		},
+		"empty-separator": {
+			name:          "happycat",
+			separator:     stringPointer(""),
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			words, sep, pfx, err := splitPetName(testCase.name, testCase.separator, testCase.prefix)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Errorf("expected error, got: %q, %q, %q", words, sep, pfx)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if strings.Join(words, ",") != strings.Join(testCase.expectedWords, ",") {
+				t.Errorf("expected words %q, got: %q", testCase.expectedWords, words)
+			}
+
+			if sep != testCase.expectedSeparator {
+				t.Errorf("expected separator %q, got: %q", testCase.expectedSeparator, sep)
+			}
+
+			if pfx != testCase.expectedPrefix {
+				t.Errorf("expected prefix %q, got: %q", testCase.expectedPrefix, pfx)
+			}
+		})
+	}
+}
+
 func testCheckPetLen(separator string, expectedLen int) func(input string) error {
 	return func(input string) error {
 		petNameParts := strings.Split(input, separator)
@@ -1,7 +1,10 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -13,19 +16,346 @@ import (
 	"github.com/terraform-providers/terraform-provider-random/internal/random"
 )
 
-var _ resource.Resource = (*shuffleResource)(nil)
+var (
+	_ resource.Resource                 = (*shuffleResource)(nil)
+	_ resource.ResourceWithConfigure    = (*shuffleResource)(nil)
+	_ resource.ResourceWithImportState  = (*shuffleResource)(nil)
+	_ resource.ResourceWithUpgradeState = (*shuffleResource)(nil)
+)
 
 func NewShuffleResource() resource.Resource {
 	return &shuffleResource{}
 }
 
-type shuffleResource struct{}
+type shuffleResource struct {
+	providerData *providerData
+}
 
 func (r *shuffleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_shuffle"
 }
 
 func (r *shuffleResource) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return shuffleSchemaV1(), nil
+}
+
+func (r *shuffleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.providerData = configureProviderData(req, resp)
+}
+
+func (r *shuffleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan shuffleModelV1
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := plan.Input
+	seed := plan.Seed.Value
+	resultCount := plan.ResultCount.Value
+
+	if resultCount == 0 {
+		resultCount = int64(len(input.Elems))
+	}
+
+	result := []attr.Value{}
+
+	if len(input.Elems) > 0 {
+		generator, diags := r.providerData.generator(ctx, "random_shuffle", plan.SeedAlgorithm.Value, seed, req.Config)
+
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		shuffled, err := shuffle(generator, input.Elems, resultCount)
+		if err != nil {
+			resp.Diagnostics.Append(randError(err)...)
+			return
+		}
+
+		result = shuffled
+	}
+
+	s := shuffleModelV1{
+		ID:            types.String{Value: "-"},
+		Keepers:       plan.Keepers,
+		SeedAlgorithm: plan.SeedAlgorithm,
+		Input:         plan.Input,
+		Result: types.List{
+			Unknown:  false,
+			Null:     false,
+			Elems:    result,
+			ElemType: types.StringType,
+		},
+	}
+
+	if plan.Seed.Null {
+		s.Seed.Null = true
+	} else {
+		s.Seed.Value = seed
+	}
+
+	if plan.ResultCount.Null {
+		s.ResultCount.Null = true
+	} else {
+		s.ResultCount.Value = resultCount
+	}
+
+	diags = resp.State.Set(ctx, s)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *shuffleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update ensures the plan value is copied to the state to complete the update.
+func (r *shuffleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model shuffleModelV1
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *shuffleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// ImportState sets the input, result and other attributes of the resource from an import ID which is a JSON
+// object of the attributes. The result must be a valid selection from the input for the result_count. If a
+// seed is given, a warning is added if the seed would not have produced the result.
+func (r *shuffleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var importID struct {
+		Keepers       map[string]string `json:"keepers"`
+		Seed          *string           `json:"seed"`
+		SeedAlgorithm *string           `json:"seed_algorithm"`
+		Input         []string          `json:"input"`
+		ResultCount   *int64            `json:"result_count"`
+		Result        []string          `json:"result"`
+	}
+
+	decoder := json.NewDecoder(bytes.NewBufferString(req.ID))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&importID); err != nil {
+		resp.Diagnostics.AddError(
+			"Import Random Shuffle Error",
+			"Invalid import usage: expecting a JSON object with input and result lists, and optional result_count, "+
+				"seed, seed_algorithm and keepers.\n\n"+
+				fmt.Sprintf("Original Error: %s", err),
+		)
+		return
+	}
+
+	if importID.Input == nil || importID.Result == nil {
+		resp.Diagnostics.AddError(
+			"Import Random Shuffle Error",
+			"Invalid import usage: expecting a JSON object with input and result lists, and optional result_count, "+
+				"seed, seed_algorithm and keepers.",
+		)
+		return
+	}
+
+	if importID.SeedAlgorithm != nil && !isSeedAlgorithm(*importID.SeedAlgorithm) {
+		resp.Diagnostics.AddError(
+			"Import Random Shuffle Error",
+			fmt.Sprintf("The seed_algorithm must be one of %q, got: %q.", random.SeedAlgorithms, *importID.SeedAlgorithm),
+		)
+		return
+	}
+
+	resultCount := int64(len(importID.Input))
+
+	if importID.ResultCount != nil && *importID.ResultCount > 0 {
+		resultCount = *importID.ResultCount
+	} else if len(importID.Result) != len(importID.Input) {
+		resultCount = int64(len(importID.Result))
+	}
+
+	if err := validateShuffleResult(importID.Input, importID.Result, resultCount); err != nil {
+		resp.Diagnostics.AddError(
+			"Import Random Shuffle Error",
+			fmt.Sprintf("The result is not a valid shuffle of the input: %s.", err),
+		)
+		return
+	}
+
+	state := shuffleModelV1{
+		ID:            types.String{Value: "-"},
+		Keepers:       importKeepers(importID.Keepers),
+		Seed:          types.String{Null: true},
+		SeedAlgorithm: types.String{Value: random.SeedAlgorithmV1},
+		Input:         importStringList(importID.Input),
+		ResultCount:   types.Int64{Null: true},
+		Result:        importStringList(importID.Result),
+	}
+
+	if importID.ResultCount != nil || resultCount != int64(len(importID.Input)) {
+		state.ResultCount = types.Int64{Value: resultCount}
+	}
+
+	if importID.SeedAlgorithm != nil {
+		state.SeedAlgorithm.Value = *importID.SeedAlgorithm
+	}
+
+	if importID.Seed != nil {
+		state.Seed = types.String{Value: *importID.Seed}
+
+		algorithm, diags := importSeedAlgorithm(*importID.Seed, importID.SeedAlgorithm, func(generator random.Generator) (bool, error) {
+			result := []attr.Value{}
+
+			if len(state.Input.Elems) > 0 {
+				var err error
+
+				result, err = shuffle(generator, state.Input.Elems, resultCount)
+				if err != nil {
+					return false, err
+				}
+			}
+
+			return state.Result.Equal(types.List{ElemType: types.StringType, Elems: result}), nil
+		})
+		resp.Diagnostics.Append(diags...)
+
+		state.SeedAlgorithm.Value = algorithm
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *shuffleResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	schemaV0 := shuffleSchemaV0()
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &schemaV0,
+			StateUpgrader: upgradeShuffleStateV0toV1,
+		},
+	}
+}
+
+func upgradeShuffleStateV0toV1(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	type shuffleModelV0 struct {
+		ID          types.String `tfsdk:"id"`
+		Keepers     types.Map    `tfsdk:"keepers"`
+		Seed        types.String `tfsdk:"seed"`
+		Input       types.List   `tfsdk:"input"`
+		ResultCount types.Int64  `tfsdk:"result_count"`
+		Result      types.List   `tfsdk:"result"`
+	}
+
+	var shuffleDataV0 shuffleModelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &shuffleDataV0)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Results in version 0 state were generated with the original seed algorithm.
+	shuffleDataV1 := shuffleModelV1{
+		ID:            shuffleDataV0.ID,
+		Keepers:       shuffleDataV0.Keepers,
+		Seed:          shuffleDataV0.Seed,
+		SeedAlgorithm: types.String{Value: random.SeedAlgorithmV1},
+		Input:         shuffleDataV0.Input,
+		ResultCount:   shuffleDataV0.ResultCount,
+		Result:        shuffleDataV0.Result,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, shuffleDataV1)...)
+}
+
+func shuffleSchemaV1() tfsdk.Schema {
+	return tfsdk.Schema{
+		Version: 1,
+		Description: "The resource `random_shuffle` generates a random permutation of a list of strings " +
+			"given as an argument.",
+		Attributes: map[string]tfsdk.Attribute{
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					planmodifiers.RequiresReplaceIfValuesNotNull(),
+				},
+			},
+			"seed": {
+				Description: "Arbitrary string with which to seed the random number generator, in order to " +
+					"produce less-volatile permutations of the list.\n" +
+					"\n" +
+					"The same permutation is always produced for a given seed, `input`, `result_count` and " +
+					"`seed_algorithm`.",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					resource.RequiresReplace(),
+				},
+			},
+			"seed_algorithm": seedAlgorithmAttribute(),
+			"input": {
+				Description: "The list of strings to shuffle.",
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+				Required: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					resource.RequiresReplace(),
+				},
+			},
+			"result_count": {
+				Description: "The number of results to return. Defaults to the number of items in the " +
+					"`input` list. If fewer items are requested, some elements will be excluded from the " +
+					"result. If more items are requested, items will be repeated in the result but not more " +
+					"frequently than the number of items in the input list.",
+				Type:     types.Int64Type,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					resource.RequiresReplace(),
+				},
+			},
+			"result": {
+				Description: "Random permutation of the list of strings given in `input`.",
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+				Computed: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					resource.UseStateForUnknown(),
+				},
+			},
+			"id": {
+				Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
+				Type:        types.StringType,
+				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					resource.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func shuffleSchemaV0() tfsdk.Schema {
 	return tfsdk.Schema{
 		Description: "The resource `random_shuffle` generates a random permutation of a list of strings " +
 			"given as an argument.",
@@ -94,103 +424,75 @@ func (r *shuffleResource) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnos
 				},
 			},
 		},
-	}, nil
-}
-
-func (r *shuffleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan shuffleModelV0
-	diags := req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
 	}
+}
 
-	input := plan.Input
-	seed := plan.Seed.Value
-	resultCount := plan.ResultCount.Value
-
-	if resultCount == 0 {
-		resultCount = int64(len(input.Elems))
-	}
+type shuffleModelV1 struct {
+	ID            types.String `tfsdk:"id"`
+	Keepers       types.Map    `tfsdk:"keepers"`
+	Seed          types.String `tfsdk:"seed"`
+	SeedAlgorithm types.String `tfsdk:"seed_algorithm"`
+	Input         types.List   `tfsdk:"input"`
+	ResultCount   types.Int64  `tfsdk:"result_count"`
+	Result        types.List   `tfsdk:"result"`
+}
 
+// shuffle returns resultCount elements of input, taken from successive permutations of input. Each
+// element therefore appears no more frequently than the number of times it appears in input in every
+// len(input) elements of the result.
+func shuffle(generator random.Generator, input []attr.Value, resultCount int64) ([]attr.Value, error) {
 	result := make([]attr.Value, 0, resultCount)
 
-	if len(input.Elems) > 0 {
-		rand := random.NewRand(seed)
-
-		// Keep producing permutations until we fill our result
-	Batches:
-		for {
-			perm := rand.Perm(len(input.Elems))
+	// Keep producing permutations until we fill our result
+	for int64(len(result)) < resultCount {
+		perm, err := generator.Perm(len(input))
+		if err != nil {
+			return nil, err
+		}
 
-			for _, i := range perm {
-				result = append(result, input.Elems[i])
+		for _, i := range perm {
+			result = append(result, input[i])
 
-				if int64(len(result)) >= resultCount {
-					break Batches
-				}
+			if int64(len(result)) >= resultCount {
+				break
 			}
 		}
 	}
 
-	s := shuffleModelV0{
-		ID:      types.String{Value: "-"},
-		Keepers: plan.Keepers,
-		Input:   plan.Input,
-		Result: types.List{
-			Unknown:  false,
-			Null:     false,
-			Elems:    result,
-			ElemType: types.StringType,
-		},
-	}
+	return result, nil
+}
 
-	if plan.Seed.Null {
-		s.Seed.Null = true
-	} else {
-		s.Seed.Value = seed
+// validateShuffleResult returns an error if result could not have been produced by shuffle from input for
+// resultCount. An empty input always produces an empty result.
+func validateShuffleResult(input, result []string, resultCount int64) error {
+	if len(input) == 0 {
+		resultCount = 0
 	}
 
-	if plan.ResultCount.Null {
-		s.ResultCount.Null = true
-	} else {
-		s.ResultCount.Value = resultCount
+	if int64(len(result)) != resultCount {
+		return fmt.Errorf("expected %d elements in the result, got: %d", resultCount, len(result))
 	}
 
-	diags = resp.State.Set(ctx, s)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-}
+	counts := make(map[string]int, len(input))
 
-// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
-func (r *shuffleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-}
+	for i, elem := range result {
+		// Each permutation of the input starts a new batch of the result.
+		if i%len(input) == 0 {
+			for k := range counts {
+				delete(counts, k)
+			}
 
-// Update ensures the plan value is copied to the state to complete the update.
-func (r *shuffleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var model shuffleModelV0
+			for _, v := range input {
+				counts[v]++
+			}
+		}
 
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+		if counts[elem] == 0 {
+			return fmt.Errorf("the element %q at index %d is not in the input, or appears more often than in the input", elem, i)
+		}
 
-	if resp.Diagnostics.HasError() {
-		return
+		counts[elem]--
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
-}
-
-// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
-// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
-func (r *shuffleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-}
-
-type shuffleModelV0 struct {
-	ID          types.String `tfsdk:"id"`
-	Keepers     types.Map    `tfsdk:"keepers"`
-	Seed        types.String `tfsdk:"seed"`
-	Input       types.List   `tfsdk:"input"`
-	ResultCount types.Int64  `tfsdk:"result_count"`
-	Result      types.List   `tfsdk:"result"`
+	return nil
 }
@@ -1,8 +1,10 @@
 package provider
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -10,15 +12,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
-// These results are current as of Go 1.6. The Go
-// "rand" package does not guarantee that the random
-// number generator will generate the same results
-// forever, but the maintainers endeavor not to change
-// it gratuitously.
-// These tests allow us to detect such changes and
-// document them when they arise, but the docs for this
-// resource specifically warn that results are not
-// guaranteed consistent across Terraform releases.
+// These results are for the v1 seed algorithm, which is
+// built on the Go "rand" package, and are current as of
+// Go 1.6. The Go "rand" package does not guarantee that
+// the random number generator will generate the same
+// results forever, but the maintainers endeavor not to
+// change it gratuitously.
+// These tests allow us to detect such changes, which
+// would alter the results of existing v1 resources.
 func TestAccResourceShuffle(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
 		ProtoV5ProviderFactories: protoV5ProviderFactories(),
@@ -27,6 +28,7 @@ func TestAccResourceShuffle(t *testing.T) {
 				Config: `resource "random_shuffle" "default_length" {
     						input = ["a", "b", "c", "d", "e"]
     						seed = "-"
+    						seed_algorithm = "v1"
 						}`,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrWith("random_shuffle.default_length", "result.#", testAccResourceShuffleCheckLength("5")),
@@ -792,6 +794,7 @@ func TestAccResourceShuffle_Shorter(t *testing.T) {
 				Config: `resource "random_shuffle" "shorter_length" {
     						input = ["a", "b", "c", "d", "e"]
     						seed = "-"
+    						seed_algorithm = "v1"
     						result_count = 3
 						}`,
 				Check: resource.ComposeTestCheckFunc(
@@ -813,6 +816,7 @@ func TestAccResourceShuffle_Longer(t *testing.T) {
 				Config: `resource "random_shuffle" "longer_length" {
     						input = ["a", "b", "c", "d", "e"]
     						seed = "-"
+    						seed_algorithm = "v1"
     						result_count = 12
 						}`,
 				Check: resource.ComposeTestCheckFunc(
@@ -917,6 +921,187 @@ func TestAccResourceShuffle_UpgradeFromVersion3_3_2(t *testing.T) {
 	})
 }
 
+func TestAccResourceShuffle_SeedAlgorithmV2(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "default_length" {
+    						input = ["a", "b", "c", "d", "e"]
+    						seed = "-"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_shuffle.default_length", "seed_algorithm", "v2"),
+					resource.TestCheckResourceAttrWith("random_shuffle.default_length", "result.#", testAccResourceShuffleCheckLength("5")),
+					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.0", "b"),
+					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.1", "d"),
+					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.2", "e"),
+					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.3", "a"),
+					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.4", "c"),
+				),
+			},
+			{
+				Config: `resource "random_shuffle" "default_length" {
+    						input = ["a", "b", "c", "d", "e"]
+    						seed = "-"
+    						seed_algorithm = "v1"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_shuffle.default_length", "seed_algorithm", "v1"),
+					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.0", "a"),
+					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.1", "c"),
+					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.2", "b"),
+					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.3", "e"),
+					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.4", "d"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_Import(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "test" {
+							input        = ["a", "b", "c", "d", "e"]
+							result_count = 8
+							seed         = "-"
+							keepers = {
+								env = "prod"
+							}
+						}`,
+			},
+			{
+				ResourceName: "random_shuffle.test",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					var input, result []string
+
+					if err := testExtractResourceAttrList("random_shuffle.test", "input", &input)(s); err != nil {
+						return "", err
+					}
+
+					if err := testExtractResourceAttrList("random_shuffle.test", "result", &result)(s); err != nil {
+						return "", err
+					}
+
+					id, err := json.Marshal(map[string]interface{}{
+						"input":        input,
+						"result":       result,
+						"result_count": 8,
+						"seed":         "-",
+						"keepers":      map[string]string{"env": "prod"},
+					})
+
+					return string(id), err
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_Import_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "test" {
+							input = ["a", "b", "c"]
+						}`,
+				ResourceName:  "random_shuffle.test",
+				ImportState:   true,
+				ImportStateId: `{"input": ["a", "b", "c"], "result": ["a", "a", "b"]}`,
+				ExpectError:   regexp.MustCompile(`The result is not a valid shuffle of the input`),
+			},
+			{
+				Config: `resource "random_shuffle" "test" {
+							input = ["a", "b", "c"]
+						}`,
+				ResourceName:  "random_shuffle.test",
+				ImportState:   true,
+				ImportStateId: `a,b,c`,
+				ExpectError:   regexp.MustCompile(`Invalid import usage`),
+			},
+		},
+	})
+}
+
+func TestValidateShuffleResult(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         []string
+		result        []string
+		resultCount   int64
+		expectedError bool
+	}{
+		"permutation": {
+			input:       []string{"a", "b", "c"},
+			result:      []string{"c", "a", "b"},
+			resultCount: 3,
+		},
+		"shorter": {
+			input:       []string{"a", "b", "c"},
+			result:      []string{"b", "a"},
+			resultCount: 2,
+		},
+		"longer": {
+			input:       []string{"a", "b", "c"},
+			result:      []string{"b", "a", "c", "c", "a"},
+			resultCount: 5,
+		},
+		"duplicate-input": {
+			input:       []string{"a", "a", "b"},
+			result:      []string{"a", "b", "a"},
+			resultCount: 3,
+		},
+		"empty-input": {
+			input:       []string{},
+			result:      []string{},
+			resultCount: 3,
+		},
+		"wrong-count": {
+			input:         []string{"a", "b", "c"},
+			result:        []string{"a", "b"},
+			resultCount:   3,
+			expectedError: true,
+		},
+		"repeated-within-permutation": {
+			input:         []string{"a", "b", "c"},
+			result:        []string{"a", "b", "a", "c"},
+			resultCount:   4,
+			expectedError: true,
+		},
+		"not-in-input": {
+			input:         []string{"a", "b", "c"},
+			result:        []string{"a", "b", "d"},
+			resultCount:   3,
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateShuffleResult(testCase.input, testCase.result, testCase.resultCount)
+
+			if testCase.expectedError && err == nil {
+				t.Errorf("expected error")
+			}
+
+			if !testCase.expectedError && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func testAccResourceShuffleCheckLength(expectedLength string) func(input string) error {
 	return func(input string) error {
 		if input != expectedLength {
@@ -2,22 +2,26 @@ package provider
 
 import (
 	"context"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"github.com/terraform-providers/terraform-provider-random/internal/diagnostics"
 	"github.com/terraform-providers/terraform-provider-random/internal/planmodifiers"
 	"github.com/terraform-providers/terraform-provider-random/internal/random"
 )
 
 var (
 	_ resource.Resource                 = (*stringResource)(nil)
+	_ resource.ResourceWithConfigure    = (*stringResource)(nil)
 	_ resource.ResourceWithImportState  = (*stringResource)(nil)
+	_ resource.ResourceWithModifyPlan   = (*stringResource)(nil)
 	_ resource.ResourceWithUpgradeState = (*stringResource)(nil)
 )
 
@@ -25,7 +29,9 @@ func NewStringResource() resource.Resource {
 	return &stringResource{}
 }
 
-type stringResource struct{}
+type stringResource struct {
+	providerData *providerData
+}
 
 func (r *stringResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_string"
@@ -35,6 +41,24 @@ func (r *stringResource) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnost
 	return stringSchemaV3(), nil
 }
 
+func (r *stringResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.providerData = configureProviderData(req, resp)
+}
+
+// ModifyPlan applies any provider defaults for attributes which have not been configured and
+// determines whether the resource requires replacement.
+func (r *stringResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var defaults *stringDefaultsModel
+
+	if r.providerData != nil {
+		defaults = r.providerData.stringDefaults
+	}
+
+	modifyStringPlan(ctx, defaults, types.String{Null: true}, req, resp)
+
+	modifyRotationPlan(ctx, req, resp)
+}
+
 func (r *stringResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan stringModelV3
 
@@ -45,35 +69,58 @@ func (r *stringResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	params := random.StringParams{
-		Length:          plan.Length.Value,
-		Upper:           plan.Upper.Value,
-		MinUpper:        plan.MinUpper.Value,
-		Lower:           plan.Lower.Value,
-		MinLower:        plan.MinLower.Value,
-		Numeric:         plan.Numeric.Value,
-		MinNumeric:      plan.MinNumeric.Value,
-		Special:         plan.Special.Value,
-		MinSpecial:      plan.MinSpecial.Value,
-		OverrideSpecial: plan.OverrideSpecial.Value,
-	}
-
-	result, err := random.CreateString(params)
+		Length:            plan.Length.Value,
+		Upper:             plan.Upper.Value,
+		MinUpper:          plan.MinUpper.Value,
+		Lower:             plan.Lower.Value,
+		MinLower:          plan.MinLower.Value,
+		Numeric:           plan.Numeric.Value,
+		MinNumeric:        plan.MinNumeric.Value,
+		Special:           plan.Special.Value,
+		MinSpecial:        plan.MinSpecial.Value,
+		OverrideSpecial:   plan.OverrideSpecial.Value,
+		CharacterClasses:  characterClasses(plan.CharacterClasses),
+		ExcludeCharacters: plan.ExcludeCharacters.Value,
+		ExcludeSimilar:    plan.ExcludeSimilar.Value,
+		Pronounceable:     plan.Mode.Value == stringModePronounceable,
+	}
+
+	source, diags := r.providerData.randSource(ctx, "random_string", req.Config)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := random.CreateString(source, params)
 	if err != nil {
-		resp.Diagnostics.Append(diagnostics.RandomReadError(err.Error())...)
+		resp.Diagnostics.Append(randError(err)...)
 		return
 	}
 
 	plan.ID = types.String{Value: string(result)}
 	plan.Result = types.String{Value: string(result)}
+	plan.ByteLength = types.Int64{Value: int64(len(result))}
+
+	plan.CreatedAt, plan.ExpiresAt, err = rotationTimestamps(plan.CreatedAt, plan.RotationPeriod, plan.RotateAfter, time.Now())
+	if err != nil {
+		resp.Diagnostics.AddError("Create Random String Error", err.Error())
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
-// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+// Read only sets entropy_bits, byte_length and created_at for resources created before the attributes were
+// introduced, as the state in ReadResourceResponse is otherwise already populated.
 func (r *stringResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readStringState(ctx, resp)
+	readRotationState(ctx, resp)
 }
 
-// Update ensures the plan value is copied to the state to complete the update.
+// Update ensures the plan value is copied to the state to complete the update. created_at and expires_at are
+// set if they are unknown, as rotation_period or rotate_after have changed.
 func (r *stringResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var model stringModelV3
 
@@ -83,6 +130,17 @@ func (r *stringResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	if model.CreatedAt.IsUnknown() || model.ExpiresAt.IsUnknown() {
+		createdAt, expiresAt, err := rotationTimestamps(model.CreatedAt, model.RotationPeriod, model.RotateAfter, time.Now())
+		if err != nil {
+			resp.Diagnostics.AddError("Update Random String Error", err.Error())
+			return
+		}
+
+		model.CreatedAt = createdAt
+		model.ExpiresAt = expiresAt
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
@@ -91,27 +149,41 @@ func (r *stringResource) Update(ctx context.Context, req resource.UpdateRequest,
 func (r *stringResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 }
 
+// ImportState sets the result, and the attributes with which it was generated, from the import ID. See
+// importString for the import IDs which are accepted.
 func (r *stringResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id := req.ID
+	imported := importString(req.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	state := stringModelV3{
-		ID:              types.String{Value: id},
-		Result:          types.String{Value: id},
-		Length:          types.Int64{Value: int64(len(id))},
-		Special:         types.Bool{Value: true},
-		Upper:           types.Bool{Value: true},
-		Lower:           types.Bool{Value: true},
-		Number:          types.Bool{Value: true},
-		Numeric:         types.Bool{Value: true},
-		MinSpecial:      types.Int64{Value: 0},
-		MinUpper:        types.Int64{Value: 0},
-		MinLower:        types.Int64{Value: 0},
-		MinNumeric:      types.Int64{Value: 0},
-		OverrideSpecial: types.String{Null: true},
-		Keepers:         types.Map{Null: true},
-	}
-
-	state.Keepers.ElemType = types.StringType
+		ID:                imported.Result,
+		Result:            imported.Result,
+		Length:            imported.Length,
+		ByteLength:        imported.ByteLength,
+		Special:           imported.Special,
+		Upper:             imported.Upper,
+		Lower:             imported.Lower,
+		Number:            imported.Numeric,
+		Numeric:           imported.Numeric,
+		MinSpecial:        imported.MinSpecial,
+		MinUpper:          imported.MinUpper,
+		MinLower:          imported.MinLower,
+		MinNumeric:        imported.MinNumeric,
+		OverrideSpecial:   imported.OverrideSpecial,
+		Keepers:           imported.Keepers,
+		MinEntropyBits:    types.Float64{Null: true},
+		EntropyBits:       imported.EntropyBits,
+		ExcludeCharacters: imported.ExcludeCharacters,
+		ExcludeSimilar:    imported.ExcludeSimilar,
+		Mode:              imported.Mode,
+		RotationPeriod:    types.String{Null: true},
+		RotateAfter:       types.String{Null: true},
+		CreatedAt:         types.String{Value: time.Now().UTC().Truncate(time.Second).Format(time.RFC3339)},
+		ExpiresAt:         types.String{Null: true},
+		CharacterClasses:  imported.CharacterClasses,
+	}
 
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -224,20 +296,30 @@ func upgradeStringStateV1toV3(ctx context.Context, req resource.UpgradeStateRequ
 	}
 
 	stringDataV3 := stringModelV3{
-		Keepers:         stringDataV1.Keepers,
-		Length:          length,
-		Special:         special,
-		Upper:           upper,
-		Lower:           lower,
-		Number:          number,
-		Numeric:         number,
-		MinNumeric:      minNumeric,
-		MinUpper:        minUpper,
-		MinLower:        minLower,
-		MinSpecial:      minSpecial,
-		OverrideSpecial: stringDataV1.OverrideSpecial,
-		Result:          stringDataV1.Result,
-		ID:              stringDataV1.ID,
+		ExcludeCharacters: types.String{Null: true},
+		ExcludeSimilar:    types.Bool{Null: true},
+		Mode:              types.String{Null: true},
+		RotationPeriod:    types.String{Null: true},
+		RotateAfter:       types.String{Null: true},
+		CreatedAt:         types.String{Null: true},
+		ExpiresAt:         types.String{Null: true},
+		MinEntropyBits:    types.Float64{Null: true},
+		EntropyBits:       types.Float64{Null: true},
+		ByteLength:        types.Int64{Null: true},
+		Keepers:           stringDataV1.Keepers,
+		Length:            length,
+		Special:           special,
+		Upper:             upper,
+		Lower:             lower,
+		Number:            number,
+		Numeric:           number,
+		MinNumeric:        minNumeric,
+		MinUpper:          minUpper,
+		MinLower:          minLower,
+		MinSpecial:        minSpecial,
+		OverrideSpecial:   stringDataV1.OverrideSpecial,
+		Result:            stringDataV1.Result,
+		ID:                stringDataV1.ID,
 	}
 
 	diags := resp.State.Set(ctx, stringDataV3)
@@ -333,20 +415,30 @@ func upgradeStringStateV2toV3(ctx context.Context, req resource.UpgradeStateRequ
 	}
 
 	stringDataV3 := stringModelV3{
-		Keepers:         stringDataV2.Keepers,
-		Length:          length,
-		Special:         special,
-		Upper:           upper,
-		Lower:           lower,
-		Number:          number,
-		Numeric:         number,
-		MinNumeric:      minNumeric,
-		MinUpper:        minUpper,
-		MinLower:        minLower,
-		MinSpecial:      minSpecial,
-		OverrideSpecial: stringDataV2.OverrideSpecial,
-		Result:          stringDataV2.Result,
-		ID:              stringDataV2.ID,
+		ExcludeCharacters: types.String{Null: true},
+		ExcludeSimilar:    types.Bool{Null: true},
+		Mode:              types.String{Null: true},
+		RotationPeriod:    types.String{Null: true},
+		RotateAfter:       types.String{Null: true},
+		CreatedAt:         types.String{Null: true},
+		ExpiresAt:         types.String{Null: true},
+		MinEntropyBits:    types.Float64{Null: true},
+		EntropyBits:       types.Float64{Null: true},
+		ByteLength:        types.Int64{Null: true},
+		Keepers:           stringDataV2.Keepers,
+		Length:            length,
+		Special:           special,
+		Upper:             upper,
+		Lower:             lower,
+		Number:            number,
+		Numeric:           number,
+		MinNumeric:        minNumeric,
+		MinUpper:          minUpper,
+		MinLower:          minLower,
+		MinSpecial:        minSpecial,
+		OverrideSpecial:   stringDataV2.OverrideSpecial,
+		Result:            stringDataV2.Result,
+		ID:                stringDataV2.ID,
 	}
 
 	diags := resp.State.Set(ctx, stringDataV3)
@@ -378,11 +470,13 @@ func stringSchemaV3() tfsdk.Schema {
 			},
 
 			"length": {
-				Description: "The length of the string desired. The minimum value for length is 1 and, length " +
-					"must also be >= (`min_upper` + `min_lower` + `min_numeric` + `min_special`).",
-				Type:          types.Int64Type,
-				Required:      true,
-				PlanModifiers: []tfsdk.AttributePlanModifier{resource.RequiresReplace()},
+				Description: "The length of the string desired, in characters. The minimum value for length is 1 " +
+					"and, length must also be >= (`min_upper` + `min_lower` + `min_numeric` + `min_special` + the " +
+					"`min` of each `character_class`). " +
+					"Required unless a default length is set in the provider `defaults` block.",
+				Type:     types.Int64Type,
+				Optional: true,
+				Computed: true,
 				Validators: []tfsdk.AttributeValidator{
 					int64validator.AtLeast(1),
 					int64validator.AtLeastSumOf(
@@ -401,7 +495,6 @@ func stringSchemaV3() tfsdk.Schema {
 				Computed:    true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
 					planmodifiers.DefaultValue(types.Bool{Value: true}),
-					planmodifiers.RequiresReplace(),
 				},
 			},
 
@@ -412,7 +505,6 @@ func stringSchemaV3() tfsdk.Schema {
 				Computed:    true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
 					planmodifiers.DefaultValue(types.Bool{Value: true}),
-					planmodifiers.RequiresReplace(),
 				},
 			},
 
@@ -423,7 +515,6 @@ func stringSchemaV3() tfsdk.Schema {
 				Computed:    true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
 					planmodifiers.DefaultValue(types.Bool{Value: true}),
-					planmodifiers.RequiresReplace(),
 				},
 			},
 
@@ -435,7 +526,6 @@ func stringSchemaV3() tfsdk.Schema {
 				Computed: true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
 					planmodifiers.NumberNumericAttributePlanModifier(),
-					planmodifiers.RequiresReplace(),
 				},
 				DeprecationMessage: "**NOTE**: This is deprecated, use `numeric` instead.",
 			},
@@ -447,7 +537,6 @@ func stringSchemaV3() tfsdk.Schema {
 				Computed:    true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
 					planmodifiers.NumberNumericAttributePlanModifier(),
-					planmodifiers.RequiresReplace(),
 				},
 			},
 
@@ -458,7 +547,6 @@ func stringSchemaV3() tfsdk.Schema {
 				Computed:    true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
 					planmodifiers.DefaultValue(types.Int64{Value: 0}),
-					planmodifiers.RequiresReplace(),
 				},
 			},
 
@@ -469,7 +557,6 @@ func stringSchemaV3() tfsdk.Schema {
 				Computed:    true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
 					planmodifiers.DefaultValue(types.Int64{Value: 0}),
-					planmodifiers.RequiresReplace(),
 				},
 			},
 
@@ -480,7 +567,6 @@ func stringSchemaV3() tfsdk.Schema {
 				Computed:    true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
 					planmodifiers.DefaultValue(types.Int64{Value: 0}),
-					planmodifiers.RequiresReplace(),
 				},
 			},
 
@@ -491,7 +577,6 @@ func stringSchemaV3() tfsdk.Schema {
 				Computed:    true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
 					planmodifiers.DefaultValue(types.Int64{Value: 0}),
-					planmodifiers.RequiresReplace(),
 				},
 			},
 
@@ -501,15 +586,65 @@ func stringSchemaV3() tfsdk.Schema {
 					"still be set to true for any overwritten characters to be used in generation.",
 				Type:     types.StringType,
 				Optional: true,
+				// Computed, as the planned value can be set from the provider defaults.
+				Computed: true,
+			},
+
+			"exclude_characters": {
+				Description: "Characters which are never used in the result. Excluded characters are removed from " +
+					"every class of characters before the characters satisfying the `min_*` attributes are chosen.",
+				Type:     types.StringType,
+				Optional: true,
+				Validators: []tfsdk.AttributeValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"exclude_similar": {
+				Description: "Exclude characters which are easily mistaken for one another when read by a person " +
+					"(`0O1lI|` and backtick) from the result. Default value is `false`.",
+				Type:     types.BoolType,
+				Optional: true,
+			},
+
+			"mode": modeAttribute(),
+
+			"min_entropy_bits": {
+				Description: "The minimum entropy, in bits, of the result. Planning fails if `entropy_bits` " +
+					"would be less than this value.",
+				Type:     types.Float64Type,
+				Optional: true,
+				Validators: []tfsdk.AttributeValidator{
+					float64validator.AtLeast(0),
+				},
+			},
+
+			"entropy_bits": {
+				Description: "An estimate of the entropy of the result, in bits, derived from `length` and the " +
+					"characters which may be used in the result. Characters required by the `min_*` " +
+					"attributes and `character_class` blocks contribute only the entropy of their own class.",
+				Type:     types.Float64Type,
+				Computed: true,
+			},
+
+			"byte_length": {
+				Description: "The length of the result in bytes, when encoded as UTF-8. This differs from " +
+					"`length` when the result contains multi-byte characters.",
+				Type:     types.Int64Type,
+				Computed: true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
-					resource.RequiresReplaceIf(
-						planmodifiers.RequiresReplaceUnlessEmptyStringToNull(),
-						"Replace on modification unless updating from empty string (\"\") to null.",
-						"Replace on modification unless updating from empty string (`\"\"`) to `null`.",
-					),
+					resource.UseStateForUnknown(),
 				},
 			},
 
+			"rotation_period": rotationPeriodAttribute(),
+
+			"rotate_after": rotateAfterAttribute(),
+
+			"created_at": createdAtAttribute(),
+
+			"expires_at": expiresAtAttribute(),
+
 			"result": {
 				Description: "The generated random string.",
 				Type:        types.StringType,
@@ -528,6 +663,9 @@ func stringSchemaV3() tfsdk.Schema {
 				},
 			},
 		},
+		Blocks: map[string]tfsdk.Block{
+			"character_class": characterClassBlock(),
+		},
 	}
 }
 
@@ -866,18 +1004,29 @@ func stringSchemaV1() tfsdk.Schema {
 }
 
 type stringModelV3 struct {
-	ID              types.String `tfsdk:"id"`
-	Keepers         types.Map    `tfsdk:"keepers"`
-	Length          types.Int64  `tfsdk:"length"`
-	Special         types.Bool   `tfsdk:"special"`
-	Upper           types.Bool   `tfsdk:"upper"`
-	Lower           types.Bool   `tfsdk:"lower"`
-	Number          types.Bool   `tfsdk:"number"`
-	Numeric         types.Bool   `tfsdk:"numeric"`
-	MinNumeric      types.Int64  `tfsdk:"min_numeric"`
-	MinUpper        types.Int64  `tfsdk:"min_upper"`
-	MinLower        types.Int64  `tfsdk:"min_lower"`
-	MinSpecial      types.Int64  `tfsdk:"min_special"`
-	OverrideSpecial types.String `tfsdk:"override_special"`
-	Result          types.String `tfsdk:"result"`
+	ID                types.String          `tfsdk:"id"`
+	Keepers           types.Map             `tfsdk:"keepers"`
+	Length            types.Int64           `tfsdk:"length"`
+	Special           types.Bool            `tfsdk:"special"`
+	Upper             types.Bool            `tfsdk:"upper"`
+	Lower             types.Bool            `tfsdk:"lower"`
+	Number            types.Bool            `tfsdk:"number"`
+	Numeric           types.Bool            `tfsdk:"numeric"`
+	MinNumeric        types.Int64           `tfsdk:"min_numeric"`
+	MinUpper          types.Int64           `tfsdk:"min_upper"`
+	MinLower          types.Int64           `tfsdk:"min_lower"`
+	MinSpecial        types.Int64           `tfsdk:"min_special"`
+	OverrideSpecial   types.String          `tfsdk:"override_special"`
+	Result            types.String          `tfsdk:"result"`
+	MinEntropyBits    types.Float64         `tfsdk:"min_entropy_bits"`
+	EntropyBits       types.Float64         `tfsdk:"entropy_bits"`
+	ByteLength        types.Int64           `tfsdk:"byte_length"`
+	ExcludeCharacters types.String          `tfsdk:"exclude_characters"`
+	ExcludeSimilar    types.Bool            `tfsdk:"exclude_similar"`
+	Mode              types.String          `tfsdk:"mode"`
+	RotationPeriod    types.String          `tfsdk:"rotation_period"`
+	RotateAfter       types.String          `tfsdk:"rotate_after"`
+	CreatedAt         types.String          `tfsdk:"created_at"`
+	ExpiresAt         types.String          `tfsdk:"expires_at"`
+	CharacterClasses  []characterClassModel `tfsdk:"character_class"`
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strconv"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -27,9 +28,10 @@ func TestAccResourceString_Import(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "random_string.basic",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "random_string.basic",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"created_at"},
 			},
 		},
 	})
@@ -892,6 +894,394 @@ func TestAccResourceString_Min(t *testing.T) {
 	})
 }
 
+func TestAccResourceString_CharacterClass(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "character_class" {
+							length  = 16
+							upper   = false
+							numeric = false
+							special = false
+
+							character_class {
+								name       = "hex"
+								characters = "0123456789abcdef"
+								min        = 4
+								max        = 6
+							}
+
+							character_class {
+								name       = "symbols"
+								characters = "~^"
+								min        = 1
+								max        = 1
+							}
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("random_string.character_class", "result", testCheckLen(16)),
+					resource.TestMatchResourceAttr("random_string.character_class", "result", regexp.MustCompile(`^[a-z0-9]*[~^][a-z0-9]*$`)),
+					resource.TestMatchResourceAttr("random_string.character_class", "result", regexp.MustCompile(`^([^0-9a-f]*[0-9a-f]){4,6}[^0-9a-f]*$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_CharacterClass_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "character_class" {
+							length = 8
+
+							character_class {
+								name       = "symbols"
+								characters = "~^"
+							}
+
+							character_class {
+								name       = "symbols"
+								characters = "|"
+							}
+						}`,
+				ExpectError: regexp.MustCompile(`.*Each character_class block must have a unique name, got duplicate:\s"symbols"`),
+			},
+			{
+				Config: `resource "random_string" "character_class" {
+							length = 8
+
+							character_class {
+								name       = "symbols"
+								characters = "~^"
+								min        = 3
+								max        = 2
+							}
+						}`,
+				ExpectError: regexp.MustCompile(`.*The min of a character_class must not exceed its max \(2\), got: 3`),
+			},
+			{
+				Config: `resource "random_string" "character_class" {
+							length    = 4
+							min_upper = 2
+
+							character_class {
+								name       = "symbols"
+								characters = "~^"
+								min        = 3
+							}
+						}`,
+				ExpectError: regexp.MustCompile(`.*The length must be at least the sum of min_upper, min_lower, min_numeric,\smin_special and the min of each character_class \(5\), got: 4`),
+			},
+			{
+				Config: `resource "random_string" "character_class" {
+							length  = 4
+							upper   = false
+							lower   = false
+							special = false
+
+							character_class {
+								name       = "digits"
+								characters = "0123456789"
+								max        = 2
+							}
+						}`,
+				ExpectError: regexp.MustCompile(`.*so the length must not exceed\sthe\ssum\sof\sthose\smax\svalues\s\(2\),\sgot:\s4`),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_EntropyBits(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "entropy" {
+							length           = 16
+							min_entropy_bits = 100
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_string.entropy", "entropy_bits", "102"),
+				),
+			},
+			{
+				Config: `resource "random_string" "entropy" {
+							length           = 16
+							min_entropy_bits = 128
+						}`,
+				ExpectError: regexp.MustCompile(`.*The estimated entropy of the result \(102.00 bits\) is less than`),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_Exclude(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "exclude" {
+							length             = 64
+							override_special   = "!#|-"
+							exclude_similar    = true
+							exclude_characters = "abc-"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("random_string.exclude", "result", testCheckLen(64)),
+					resource.TestMatchResourceAttr("random_string.exclude", "result", regexp.MustCompile("^[^0O1lI|`abc-]+$")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_Exclude_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "exclude" {
+							length           = 8
+							override_special = "|"
+							min_special      = 1
+							exclude_similar  = true
+						}`,
+				ExpectError: regexp.MustCompile(`.*Every character of the special character class is excluded by`),
+			},
+			{
+				Config: `resource "random_string" "exclude" {
+							length             = 8
+							upper              = false
+							lower              = false
+							special            = false
+							exclude_characters = "0123456789"
+						}`,
+				ExpectError: regexp.MustCompile(`.*Every character which may be used in the result is either disabled or`),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_MultiByte(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "multi_byte" {
+							length           = 12
+							special          = true
+							upper            = false
+							lower            = false
+							numeric          = false
+							override_special = "€§"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("random_string.multi_byte", "result", regexp.MustCompile(`^[€§]{12}$`)),
+					resource.TestCheckResourceAttr("random_string.multi_byte", "length", "12"),
+					// € is 3 bytes and § is 2 bytes when encoded as UTF-8.
+					resource.TestCheckResourceAttrWith("random_string.multi_byte", "byte_length", testCheckIntBetween(24, 36)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_Pronounceable(t *testing.T) {
+	var result1, result2 string
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "pronounceable" {
+							length      = 8
+							mode        = "pronounceable"
+							min_numeric = 2
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_string.pronounceable", "result", &result1),
+					resource.TestMatchResourceAttr("random_string.pronounceable", "result", regexp.MustCompile(`^([bdfghjklmnprstvz][aeiou]){3}[0-9]{2}$`)),
+					resource.TestCheckResourceAttr("random_string.pronounceable", "entropy_bits", "25.6"),
+				),
+			},
+			{
+				Config: `resource "random_string" "pronounceable" {
+							length      = 8
+							min_numeric = 2
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_string.pronounceable", "result", &result2),
+					testCheckAttributeValuesDiffer(&result1, &result2),
+				),
+			},
+			{
+				Config: `resource "random_string" "pronounceable" {
+							length      = 8
+							min_numeric = 2
+							mode        = "random"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_string.pronounceable", "result", &result1),
+					testCheckAttributeValuesEqual(&result1, &result2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_Pronounceable_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "pronounceable" {
+							length          = 8
+							mode            = "pronounceable"
+							exclude_similar = true
+						}`,
+				ExpectError: regexp.MustCompile(`exclude_similar cannot be set when mode is pronounceable`),
+			},
+			{
+				Config: `resource "random_string" "pronounceable" {
+							length = 8
+							mode   = "spoken"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute mode Value must be one of`),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_ProviderDefaults(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "random" {
+							defaults {
+								string {
+									length           = 20
+									override_special = "!#@"
+									min_special      = 2
+									numeric          = false
+								}
+							}
+						}
+
+						resource "random_string" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("random_string.test", "result", testCheckLen(20)),
+					resource.TestCheckResourceAttr("random_string.test", "length", "20"),
+					resource.TestCheckResourceAttr("random_string.test", "override_special", "!#@"),
+					resource.TestCheckResourceAttr("random_string.test", "min_special", "2"),
+					resource.TestCheckResourceAttr("random_string.test", "number", "false"),
+					resource.TestCheckResourceAttr("random_string.test", "numeric", "false"),
+					resource.TestMatchResourceAttr("random_string.test", "result", regexp.MustCompile(`([!#@].*){2,}`)),
+					resource.TestMatchResourceAttr("random_string.test", "result", regexp.MustCompile(`^[^0-9]+$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_ProviderDefaults_ResourceOverride(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "random" {
+							defaults {
+								string {
+									length  = 20
+									special = false
+								}
+							}
+						}
+
+						resource "random_string" "test" {
+							length  = 12
+							special = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("random_string.test", "result", testCheckLen(12)),
+					resource.TestCheckResourceAttr("random_string.test", "special", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_ProviderDefaults_Keep(t *testing.T) {
+	var result1, result2 string
+
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				Config: `provider "random" {
+							defaults {
+								string {
+									length  = 20
+									special = false
+								}
+							}
+						}
+
+						resource "random_string" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_string.test", "result", &result1),
+				),
+			},
+			{
+				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				Config: `provider "random" {
+							defaults {
+								string {
+									length  = 20
+									special = false
+								}
+							}
+						}
+
+						resource "random_string" "test" {}`,
+				PlanOnly: true,
+			},
+			{
+				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				Config: `provider "random" {
+							defaults {
+								string {
+									length  = 20
+									special = true
+								}
+							}
+						}
+
+						resource "random_string" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_string.test", "result", &result2),
+					testCheckAttributeValuesDiffer(&result1, &result2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_ProviderDefaults_MissingLength(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      `resource "random_string" "test" {}`,
+				ExpectError: regexp.MustCompile(`.*The length attribute must be set either in the resource configuration or\nin the provider defaults block`),
+			},
+		},
+	})
+}
+
 // TestAccResourceString_StateUpgradeV1toV2 covers the state upgrade from V1 to V2.
 // This includes the deprecation of `number` and the addition of `numeric` attributes.
 // v3.2.0 was used as this is the last version before `number` was deprecated and `numeric` attribute
@@ -1299,20 +1689,30 @@ func TestUpgradeStringStateV1toV3(t *testing.T) {
 	upgradeStringStateV1toV3(context.Background(), req, resp)
 
 	expected := stringModelV3{
-		ID:              types.String{Value: "none"},
-		Keepers:         types.Map{Null: true, ElemType: types.StringType},
-		Length:          types.Int64{Value: 16},
-		Special:         types.Bool{Value: true},
-		Upper:           types.Bool{Value: true},
-		Lower:           types.Bool{Value: true},
-		Number:          types.Bool{Value: true},
-		Numeric:         types.Bool{Value: true},
-		MinNumeric:      types.Int64{Value: 0},
-		MinUpper:        types.Int64{Value: 0},
-		MinLower:        types.Int64{Value: 0},
-		MinSpecial:      types.Int64{Value: 0},
-		OverrideSpecial: types.String{Value: "!#$%\u0026*()-_=+[]{}\u003c\u003e:?"},
-		Result:          types.String{Value: "DZy_3*tnonj%Q%Yx"},
+		ExcludeCharacters: types.String{Null: true},
+		ExcludeSimilar:    types.Bool{Null: true},
+		Mode:              types.String{Null: true},
+		RotationPeriod:    types.String{Null: true},
+		RotateAfter:       types.String{Null: true},
+		CreatedAt:         types.String{Null: true},
+		ExpiresAt:         types.String{Null: true},
+		MinEntropyBits:    types.Float64{Null: true},
+		EntropyBits:       types.Float64{Null: true},
+		ByteLength:        types.Int64{Null: true},
+		ID:                types.String{Value: "none"},
+		Keepers:           types.Map{Null: true, ElemType: types.StringType},
+		Length:            types.Int64{Value: 16},
+		Special:           types.Bool{Value: true},
+		Upper:             types.Bool{Value: true},
+		Lower:             types.Bool{Value: true},
+		Number:            types.Bool{Value: true},
+		Numeric:           types.Bool{Value: true},
+		MinNumeric:        types.Int64{Value: 0},
+		MinUpper:          types.Int64{Value: 0},
+		MinLower:          types.Int64{Value: 0},
+		MinSpecial:        types.Int64{Value: 0},
+		OverrideSpecial:   types.String{Value: "!#$%\u0026*()-_=+[]{}\u003c\u003e:?"},
+		Result:            types.String{Value: "DZy_3*tnonj%Q%Yx"},
 	}
 
 	actual := stringModelV3{}
@@ -1361,20 +1761,30 @@ func TestUpgradeStringStateV1toV3_NullValues(t *testing.T) {
 	upgradeStringStateV1toV3(context.Background(), req, resp)
 
 	expected := stringModelV3{
-		ID:              types.String{Value: "none"},
-		Keepers:         types.Map{Null: true, ElemType: types.StringType},
-		Length:          types.Int64{Value: 16},
-		Special:         types.Bool{Value: true},
-		Upper:           types.Bool{Value: true},
-		Lower:           types.Bool{Value: true},
-		Number:          types.Bool{Value: true},
-		Numeric:         types.Bool{Value: true},
-		MinNumeric:      types.Int64{Value: 0},
-		MinUpper:        types.Int64{Value: 0},
-		MinLower:        types.Int64{Value: 0},
-		MinSpecial:      types.Int64{Value: 0},
-		OverrideSpecial: types.String{Null: true},
-		Result:          types.String{Value: "DZy_3*tnonj%Q%Yx"},
+		ExcludeCharacters: types.String{Null: true},
+		ExcludeSimilar:    types.Bool{Null: true},
+		Mode:              types.String{Null: true},
+		RotationPeriod:    types.String{Null: true},
+		RotateAfter:       types.String{Null: true},
+		CreatedAt:         types.String{Null: true},
+		ExpiresAt:         types.String{Null: true},
+		MinEntropyBits:    types.Float64{Null: true},
+		EntropyBits:       types.Float64{Null: true},
+		ByteLength:        types.Int64{Null: true},
+		ID:                types.String{Value: "none"},
+		Keepers:           types.Map{Null: true, ElemType: types.StringType},
+		Length:            types.Int64{Value: 16},
+		Special:           types.Bool{Value: true},
+		Upper:             types.Bool{Value: true},
+		Lower:             types.Bool{Value: true},
+		Number:            types.Bool{Value: true},
+		Numeric:           types.Bool{Value: true},
+		MinNumeric:        types.Int64{Value: 0},
+		MinUpper:          types.Int64{Value: 0},
+		MinLower:          types.Int64{Value: 0},
+		MinSpecial:        types.Int64{Value: 0},
+		OverrideSpecial:   types.String{Null: true},
+		Result:            types.String{Value: "DZy_3*tnonj%Q%Yx"},
 	}
 
 	actual := stringModelV3{}
@@ -1424,20 +1834,30 @@ func TestUpgradeStringStateV2toV3(t *testing.T) {
 	upgradeStringStateV2toV3(context.Background(), req, resp)
 
 	expected := stringModelV3{
-		ID:              types.String{Value: "none"},
-		Keepers:         types.Map{Null: true, ElemType: types.StringType},
-		Length:          types.Int64{Value: 16},
-		Special:         types.Bool{Value: true},
-		Upper:           types.Bool{Value: true},
-		Lower:           types.Bool{Value: true},
-		Number:          types.Bool{Value: true},
-		Numeric:         types.Bool{Value: true},
-		MinNumeric:      types.Int64{Value: 0},
-		MinUpper:        types.Int64{Value: 0},
-		MinLower:        types.Int64{Value: 0},
-		MinSpecial:      types.Int64{Value: 0},
-		OverrideSpecial: types.String{Value: "!#$%\u0026*()-_=+[]{}\u003c\u003e:?"},
-		Result:          types.String{Value: "DZy_3*tnonj%Q%Yx"},
+		ExcludeCharacters: types.String{Null: true},
+		ExcludeSimilar:    types.Bool{Null: true},
+		Mode:              types.String{Null: true},
+		RotationPeriod:    types.String{Null: true},
+		RotateAfter:       types.String{Null: true},
+		CreatedAt:         types.String{Null: true},
+		ExpiresAt:         types.String{Null: true},
+		MinEntropyBits:    types.Float64{Null: true},
+		EntropyBits:       types.Float64{Null: true},
+		ByteLength:        types.Int64{Null: true},
+		ID:                types.String{Value: "none"},
+		Keepers:           types.Map{Null: true, ElemType: types.StringType},
+		Length:            types.Int64{Value: 16},
+		Special:           types.Bool{Value: true},
+		Upper:             types.Bool{Value: true},
+		Lower:             types.Bool{Value: true},
+		Number:            types.Bool{Value: true},
+		Numeric:           types.Bool{Value: true},
+		MinNumeric:        types.Int64{Value: 0},
+		MinUpper:          types.Int64{Value: 0},
+		MinLower:          types.Int64{Value: 0},
+		MinSpecial:        types.Int64{Value: 0},
+		OverrideSpecial:   types.String{Value: "!#$%\u0026*()-_=+[]{}\u003c\u003e:?"},
+		Result:            types.String{Value: "DZy_3*tnonj%Q%Yx"},
 	}
 
 	actual := stringModelV3{}
@@ -1487,20 +1907,30 @@ func TestUpgradeStringStateV2toV3_NullValues(t *testing.T) {
 	upgradeStringStateV2toV3(context.Background(), req, resp)
 
 	expected := stringModelV3{
-		ID:              types.String{Value: "none"},
-		Keepers:         types.Map{Null: true, ElemType: types.StringType},
-		Length:          types.Int64{Value: 16},
-		Special:         types.Bool{Value: true},
-		Upper:           types.Bool{Value: true},
-		Lower:           types.Bool{Value: true},
-		Number:          types.Bool{Value: true},
-		Numeric:         types.Bool{Value: true},
-		MinNumeric:      types.Int64{Value: 0},
-		MinUpper:        types.Int64{Value: 0},
-		MinLower:        types.Int64{Value: 0},
-		MinSpecial:      types.Int64{Value: 0},
-		OverrideSpecial: types.String{Null: true},
-		Result:          types.String{Value: "DZy_3*tnonj%Q%Yx"},
+		ExcludeCharacters: types.String{Null: true},
+		ExcludeSimilar:    types.Bool{Null: true},
+		Mode:              types.String{Null: true},
+		RotationPeriod:    types.String{Null: true},
+		RotateAfter:       types.String{Null: true},
+		CreatedAt:         types.String{Null: true},
+		ExpiresAt:         types.String{Null: true},
+		MinEntropyBits:    types.Float64{Null: true},
+		EntropyBits:       types.Float64{Null: true},
+		ByteLength:        types.Int64{Null: true},
+		ID:                types.String{Value: "none"},
+		Keepers:           types.Map{Null: true, ElemType: types.StringType},
+		Length:            types.Int64{Value: 16},
+		Special:           types.Bool{Value: true},
+		Upper:             types.Bool{Value: true},
+		Lower:             types.Bool{Value: true},
+		Number:            types.Bool{Value: true},
+		Numeric:           types.Bool{Value: true},
+		MinNumeric:        types.Int64{Value: 0},
+		MinUpper:          types.Int64{Value: 0},
+		MinLower:          types.Int64{Value: 0},
+		MinSpecial:        types.Int64{Value: 0},
+		OverrideSpecial:   types.String{Null: true},
+		Result:            types.String{Value: "DZy_3*tnonj%Q%Yx"},
 	}
 
 	actual := stringModelV3{}
@@ -1640,6 +2070,21 @@ func testCheckLen(expectedLen int) func(input string) error {
 	}
 }
 
+func testCheckIntBetween(min, max int) func(input string) error {
+	return func(input string) error {
+		i, err := strconv.Atoi(input)
+		if err != nil {
+			return err
+		}
+
+		if i < min || i > max {
+			return fmt.Errorf("expected value between %d and %d, actual value %d", min, max, i)
+		}
+
+		return nil
+	}
+}
+
 //nolint:unparam
 func testCheckMinLen(minLen int) func(input string) error {
 	return func(input string) error {
@@ -12,10 +12,12 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-random/internal/diagnostics"
 	"github.com/terraform-providers/terraform-provider-random/internal/planmodifiers"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
 )
 
 var (
 	_ resource.Resource                = (*uuidResource)(nil)
+	_ resource.ResourceWithConfigure   = (*uuidResource)(nil)
 	_ resource.ResourceWithImportState = (*uuidResource)(nil)
 )
 
@@ -23,7 +25,9 @@ func NewUuidResource() resource.Resource {
 	return &uuidResource{}
 }
 
-type uuidResource struct{}
+type uuidResource struct {
+	providerData *providerData
+}
 
 func (r *uuidResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_uuid"
@@ -68,8 +72,36 @@ func (r *uuidResource) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostic
 	}, nil
 }
 
+func (r *uuidResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.providerData = configureProviderData(req, resp)
+}
+
 func (r *uuidResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	result, err := uuid.GenerateUUID()
+	var plan uuidModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bytes := make([]byte, 16)
+
+	source, diags := r.providerData.randSource(ctx, "random_uuid", req.Config)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := random.ReadEntropy(source, bytes)
+	if err != nil {
+		resp.Diagnostics.Append(randError(err)...)
+		return
+	}
+
+	result, err := uuid.FormatUUID(bytes)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Create Random UUID error",
@@ -80,14 +112,6 @@ func (r *uuidResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	var plan uuidModelV0
-
-	diags := req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
 	u := &uuidModelV0{
 		ID:      types.String{Value: result},
 		Result:  types.String{Value: result},
@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// rotationPeriodRegexp matches rotation periods such as 90d or 1d12h, which are made of a
+// number of days, hours, minutes and seconds in that order.
+var rotationPeriodRegexp = regexp.MustCompile(`^(?:([0-9]+)d)?(?:([0-9]+)h)?(?:([0-9]+)m)?(?:([0-9]+)s)?$`)
+
+func rotationPeriodAttribute() tfsdk.Attribute {
+	return tfsdk.Attribute{
+		Description: "The period after which the resource is replaced, generating a new result, as a number of " +
+			"days, hours, minutes and seconds such as `90d` or `1d12h`. Replacement is planned by the first plan " +
+			"after `expires_at`. Changing this updates `expires_at` without replacing the resource, unless the " +
+			"new period has already elapsed.",
+		Type:     types.StringType,
+		Optional: true,
+		Validators: []tfsdk.AttributeValidator{
+			stringvalidator.LengthAtLeast(2),
+			stringvalidator.RegexMatches(rotationPeriodRegexp, "must be a number of days, hours, minutes and "+
+				"seconds, such as 90d or 1d12h"),
+		},
+	}
+}
+
+func rotateAfterAttribute() tfsdk.Attribute {
+	return tfsdk.Attribute{
+		Description: "An [RFC 3339](https://www.rfc-editor.org/rfc/rfc3339) timestamp after which the resource " +
+			"is replaced, generating a new result. Only resources created before the timestamp are replaced, " +
+			"so the replacement is not replaced again, and a resource created after it is not replaced until " +
+			"it is changed to a later timestamp. When `rotation_period` is also set, the resource is replaced " +
+			"at whichever is earlier.",
+		Type:     types.StringType,
+		Optional: true,
+	}
+}
+
+func createdAtAttribute() tfsdk.Attribute {
+	return tfsdk.Attribute{
+		Description: "The [RFC 3339](https://www.rfc-editor.org/rfc/rfc3339) timestamp at which the resource " +
+			"was created or imported. Resources created before this attribute was introduced record the time " +
+			"at which they were first refreshed.",
+		Type:     types.StringType,
+		Computed: true,
+		PlanModifiers: []tfsdk.AttributePlanModifier{
+			resource.UseStateForUnknown(),
+		},
+	}
+}
+
+func expiresAtAttribute() tfsdk.Attribute {
+	return tfsdk.Attribute{
+		Description: "The [RFC 3339](https://www.rfc-editor.org/rfc/rfc3339) timestamp after which the resource " +
+			"is replaced, derived from `created_at`, `rotation_period` and `rotate_after`. Only set when " +
+			"`rotation_period` or `rotate_after` is set.",
+		Type:     types.StringType,
+		Computed: true,
+	}
+}
+
+// parseRotationPeriod returns the duration of a rotation period matching rotationPeriodRegexp.
+func parseRotationPeriod(period string) (time.Duration, error) {
+	matches := rotationPeriodRegexp.FindStringSubmatch(period)
+	if matches == nil {
+		return 0, fmt.Errorf("invalid rotation period: %s", period)
+	}
+
+	var duration time.Duration
+
+	for i, unit := range []time.Duration{24 * time.Hour, time.Hour, time.Minute, time.Second} {
+		if matches[i+1] == "" {
+			continue
+		}
+
+		n, err := strconv.ParseInt(matches[i+1], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid rotation period: %s", period)
+		}
+
+		duration += time.Duration(n) * unit
+	}
+
+	if duration <= 0 {
+		return 0, fmt.Errorf("rotation period must be greater than zero, got: %s", period)
+	}
+
+	return duration, nil
+}
+
+// rotationExpiresAt returns the time after which a resource created at createdAt is replaced, or nil if
+// neither period nor rotateAfter is set. rotateAfter is ignored unless it is after createdAt, as the
+// resource was created after it, such as when the resource replaced another at rotateAfter.
+func rotationExpiresAt(createdAt time.Time, period, rotateAfter types.String) (*time.Time, error) {
+	var expiresAt *time.Time
+
+	if !period.IsNull() {
+		duration, err := parseRotationPeriod(period.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		t := createdAt.Add(duration)
+		expiresAt = &t
+	}
+
+	if !rotateAfter.IsNull() {
+		t, err := time.Parse(time.RFC3339, rotateAfter.Value)
+		if err != nil {
+			return nil, fmt.Errorf("rotate_after must be an RFC 3339 timestamp, got: %s", rotateAfter.Value)
+		}
+
+		if t.After(createdAt) && (expiresAt == nil || t.Before(*expiresAt)) {
+			expiresAt = &t
+		}
+	}
+
+	return expiresAt, nil
+}
+
+// rotationTimestamps returns the values of created_at and expires_at. created_at is set to now if it is null
+// or unknown, as the resource is being created.
+func rotationTimestamps(createdAt, period, rotateAfter types.String, now time.Time) (types.String, types.String, error) {
+	created := now.UTC().Truncate(time.Second)
+
+	if !createdAt.IsNull() && !createdAt.IsUnknown() {
+		t, err := time.Parse(time.RFC3339, createdAt.Value)
+		if err != nil {
+			return types.String{}, types.String{}, err
+		}
+
+		created = t
+	}
+
+	expiresAt, err := rotationExpiresAt(created, period, rotateAfter)
+	if err != nil {
+		return types.String{}, types.String{}, err
+	}
+
+	createdAtValue := types.String{Value: created.Format(time.RFC3339)}
+
+	if expiresAt == nil {
+		return createdAtValue, types.String{Null: true}, nil
+	}
+
+	return createdAtValue, types.String{Value: expiresAt.UTC().Format(time.RFC3339)}, nil
+}
+
+// modifyRotationPlan validates rotation_period and rotate_after, and sets the planned value of expires_at.
+// When expires_at has passed, the resource is replaced, so expires_at and created_at are planned as unknown.
+func modifyRotationPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var period, rotateAfter, createdAt types.String
+
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("rotation_period"), &period)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("rotate_after"), &rotateAfter)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("created_at"), &createdAt)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if period.IsUnknown() || rotateAfter.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expires_at"), types.String{Unknown: true})...)
+		return
+	}
+
+	now := time.Now()
+
+	if !period.IsNull() {
+		if _, err := parseRotationPeriod(period.Value); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("rotation_period"), "Invalid Rotation Period", err.Error())
+		}
+	}
+
+	if !rotateAfter.IsNull() {
+		if _, err := time.Parse(time.RFC3339, rotateAfter.Value); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("rotate_after"), "Invalid Rotate After",
+				fmt.Sprintf("rotate_after must be an RFC 3339 timestamp, such as 2030-01-02T15:04:05Z, got: %s.",
+					rotateAfter.Value))
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// created_at is unknown when the resource is being created, or when it was created before created_at
+	// was introduced and has not been refreshed, in which case it is set by Update.
+	if createdAt.IsUnknown() {
+		if period.IsNull() && rotateAfter.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expires_at"), types.String{Null: true})...)
+		} else {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expires_at"), types.String{Unknown: true})...)
+		}
+
+		return
+	}
+
+	_, expiresAt, err := rotationTimestamps(createdAt, period, rotateAfter, now)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("created_at"), "Invalid Created At", err.Error())
+		return
+	}
+
+	if !expiresAt.IsNull() {
+		t, _ := time.Parse(time.RFC3339, expiresAt.Value)
+
+		if !t.After(now) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expires_at"))
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("created_at"), types.String{Unknown: true})...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expires_at"), types.String{Unknown: true})...)
+
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expires_at"), expiresAt)...)
+}
+
+// readRotationState sets created_at to the current time for resources created before the attribute was
+// introduced.
+func readRotationState(ctx context.Context, resp *resource.ReadResponse) {
+	var createdAt types.String
+
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("created_at"), &createdAt)...)
+
+	if resp.Diagnostics.HasError() || !createdAt.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("created_at"),
+		types.String{Value: time.Now().UTC().Truncate(time.Second).Format(time.RFC3339)})...)
+}
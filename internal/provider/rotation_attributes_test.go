@@ -0,0 +1,331 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestParseRotationPeriod(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		period        string
+		expected      time.Duration
+		expectedError bool
+	}{
+		"days": {
+			period:   "90d",
+			expected: 90 * 24 * time.Hour,
+		},
+		"days-hours": {
+			period:   "1d12h",
+			expected: 36 * time.Hour,
+		},
+		"all-units": {
+			period:   "1d2h3m4s",
+			expected: 26*time.Hour + 3*time.Minute + 4*time.Second,
+		},
+		"seconds": {
+			period:   "30s",
+			expected: 30 * time.Second,
+		},
+		"zero": {
+			period:        "0d",
+			expectedError: true,
+		},
+		"out-of-order": {
+			period:        "12h1d",
+			expectedError: true,
+		},
+		"unit-missing": {
+			period:        "90",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseRotationPeriod(testCase.period)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Errorf("expected error, got: %s", got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestRotationTimestamps(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 10, 1, 12, 0, 0, 500, time.UTC)
+
+	testCases := map[string]struct {
+		createdAt         types.String
+		period            types.String
+		rotateAfter       types.String
+		expectedCreatedAt string
+		expectedExpiresAt types.String
+	}{
+		"create-no-rotation": {
+			createdAt:         types.String{Unknown: true},
+			period:            types.String{Null: true},
+			rotateAfter:       types.String{Null: true},
+			expectedCreatedAt: "2022-10-01T12:00:00Z",
+			expectedExpiresAt: types.String{Null: true},
+		},
+		"create-period": {
+			createdAt:         types.String{Unknown: true},
+			period:            types.String{Value: "90d"},
+			rotateAfter:       types.String{Null: true},
+			expectedCreatedAt: "2022-10-01T12:00:00Z",
+			expectedExpiresAt: types.String{Value: "2022-12-30T12:00:00Z"},
+		},
+		"existing-period": {
+			createdAt:         types.String{Value: "2022-09-01T00:00:00Z"},
+			period:            types.String{Value: "30d"},
+			rotateAfter:       types.String{Null: true},
+			expectedCreatedAt: "2022-09-01T00:00:00Z",
+			expectedExpiresAt: types.String{Value: "2022-10-01T00:00:00Z"},
+		},
+		"rotate-after-earlier": {
+			createdAt:         types.String{Value: "2022-09-01T00:00:00Z"},
+			period:            types.String{Value: "90d"},
+			rotateAfter:       types.String{Value: "2022-10-15T02:00:00+02:00"},
+			expectedCreatedAt: "2022-09-01T00:00:00Z",
+			expectedExpiresAt: types.String{Value: "2022-10-15T00:00:00Z"},
+		},
+		"rotate-after-before-created": {
+			createdAt:         types.String{Value: "2022-09-01T00:00:00Z"},
+			period:            types.String{Null: true},
+			rotateAfter:       types.String{Value: "2022-08-01T00:00:00Z"},
+			expectedCreatedAt: "2022-09-01T00:00:00Z",
+			expectedExpiresAt: types.String{Null: true},
+		},
+		"rotate-after-before-created-period": {
+			createdAt:         types.String{Value: "2022-09-01T00:00:00Z"},
+			period:            types.String{Value: "30d"},
+			rotateAfter:       types.String{Value: "2022-08-01T00:00:00Z"},
+			expectedCreatedAt: "2022-09-01T00:00:00Z",
+			expectedExpiresAt: types.String{Value: "2022-10-01T00:00:00Z"},
+		},
+		"create-rotate-after-elapsed": {
+			createdAt:         types.String{Unknown: true},
+			period:            types.String{Null: true},
+			rotateAfter:       types.String{Value: "2022-10-01T11:00:00Z"},
+			expectedCreatedAt: "2022-10-01T12:00:00Z",
+			expectedExpiresAt: types.String{Null: true},
+		},
+		"rotate-after-later": {
+			createdAt:         types.String{Value: "2022-09-01T00:00:00Z"},
+			period:            types.String{Value: "1d"},
+			rotateAfter:       types.String{Value: "2022-10-15T00:00:00Z"},
+			expectedCreatedAt: "2022-09-01T00:00:00Z",
+			expectedExpiresAt: types.String{Value: "2022-09-02T00:00:00Z"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			createdAt, expiresAt, err := rotationTimestamps(testCase.createdAt, testCase.period, testCase.rotateAfter, now)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if createdAt.Value != testCase.expectedCreatedAt {
+				t.Errorf("expected created_at %s, got: %s", testCase.expectedCreatedAt, createdAt.Value)
+			}
+
+			if !expiresAt.Equal(testCase.expectedExpiresAt) {
+				t.Errorf("expected expires_at %s, got: %s", testCase.expectedExpiresAt, expiresAt)
+			}
+		})
+	}
+}
+
+func TestAccResourcePassword_RotationPeriod(t *testing.T) {
+	var result1, result2 string
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length          = 12
+							rotation_period = "90d"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_password.test", "result", &result1),
+					testCheckExpiresAfter("random_password.test", 90*24*time.Hour),
+				),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length          = 12
+							rotation_period = "30d"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_password.test", "result", &result2),
+					testCheckAttributeValuesEqual(&result1, &result2),
+					testCheckExpiresAfter("random_password.test", 30*24*time.Hour),
+				),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_password.test", "result", &result2),
+					testCheckAttributeValuesEqual(&result1, &result2),
+					resource.TestCheckNoResourceAttr("random_password.test", "expires_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_RotationPeriod_Elapsed(t *testing.T) {
+	var result1, result2 string
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "test" {
+							length          = 12
+							rotation_period = "1s"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_string.test", "result", &result1),
+					testCheckExpiresAfter("random_string.test", time.Second),
+				),
+				// The period may elapse before the plan following the apply.
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				PreConfig: func() {
+					time.Sleep(2 * time.Second)
+				},
+				Config: `resource "random_string" "test" {
+							length          = 12
+							rotation_period = "1s"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_string.test", "result", &result2),
+					testCheckAttributeValuesDiffer(&result1, &result2),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceString_RotateAfter_Elapsed(t *testing.T) {
+	var result1, result2, result3 string
+
+	rotateAfter := time.Now().UTC().Add(2 * time.Second).Format(time.RFC3339)
+	config := fmt.Sprintf(`resource "random_string" "test" {
+							length       = 12
+							rotate_after = %q
+						}`, rotateAfter)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_string.test", "result", &result1),
+					resource.TestCheckResourceAttr("random_string.test", "expires_at", rotateAfter),
+				),
+				// rotate_after may elapse before the plan following the apply.
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				PreConfig: func() {
+					time.Sleep(3 * time.Second)
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_string.test", "result", &result2),
+					testCheckAttributeValuesDiffer(&result1, &result2),
+					resource.TestCheckNoResourceAttr("random_string.test", "expires_at"),
+				),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_string.test", "result", &result3),
+					testCheckAttributeValuesEqual(&result2, &result3),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_RotateAfter_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "test" {
+							length       = 12
+							rotate_after = "tomorrow"
+						}`,
+				ExpectError: regexp.MustCompile(`rotate_after must be an RFC 3339 timestamp`),
+			},
+		},
+	})
+}
+
+// testCheckExpiresAfter verifies that the expires_at attribute of a resource is period after its created_at
+// attribute.
+func testCheckExpiresAfter(resourceName string, period time.Duration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource name %s not found in state", resourceName)
+		}
+
+		createdAt, err := time.Parse(time.RFC3339, rs.Primary.Attributes["created_at"])
+		if err != nil {
+			return err
+		}
+
+		expiresAt, err := time.Parse(time.RFC3339, rs.Primary.Attributes["expires_at"])
+		if err != nil {
+			return err
+		}
+
+		if got := expiresAt.Sub(createdAt); got != period {
+			return fmt.Errorf("expected expires_at %s after created_at, got: %s", period, got)
+		}
+
+		return nil
+	}
+}
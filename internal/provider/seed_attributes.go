@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/planmodifiers"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+// seedAlgorithmAttribute returns the seed_algorithm attribute used by the random_integer and
+// random_shuffle resources.
+func seedAlgorithmAttribute() tfsdk.Attribute {
+	return tfsdk.Attribute{
+		Description: "The algorithm used to generate the result from `seed`. The result produced by " +
+			"each algorithm for a given seed will never change between provider versions. One of `" +
+			strings.Join(random.SeedAlgorithms, "`, `") + "`. Defaults to `" + random.LatestSeedAlgorithm +
+			"` for new resources. Resources created with version 3.4.3 and earlier use `" +
+			random.SeedAlgorithmV1 + "`.",
+		Type:     types.StringType,
+		Optional: true,
+		Computed: true,
+		Validators: []tfsdk.AttributeValidator{
+			stringvalidator.OneOf(random.SeedAlgorithms...),
+		},
+		PlanModifiers: []tfsdk.AttributePlanModifier{
+			planmodifiers.DefaultValueOnCreate(types.String{Value: random.LatestSeedAlgorithm}),
+			resource.RequiresReplace(),
+		},
+	}
+}
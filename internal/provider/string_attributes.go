@@ -0,0 +1,660 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/planmodifiers"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+// stringReplaceAttributes are the random_password and random_string attributes which
+// trigger replacement when changed. Replacement for these attributes is determined in
+// modifyStringPlan, rather than by attribute plan modifiers, as their planned values can
+// be altered by provider defaults after the attribute plan modifiers have run.
+var stringReplaceAttributes = []string{
+	"character_class",
+	"exclude_characters",
+	"exclude_similar",
+	"length",
+	"lower",
+	"min_lower",
+	"min_numeric",
+	"min_special",
+	"min_upper",
+	"mode",
+	"number",
+	"numeric",
+	"override_special",
+	"special",
+	"upper",
+}
+
+// modifyStringPlan is used by the random_password and random_string resources to
+// apply any defaults configured on the provider for attributes which are not set in
+// the resource configuration, and to determine whether the resource requires replacement.
+// If policy is not null, the values of the random_password policy take precedence over
+// the provider defaults, and the plan is validated against the policy.
+func modifyStringPlan(ctx context.Context, defaults *stringDefaultsModel, policy types.String, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Plan is null when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// The framework marks computed attributes which are null in the configuration as unknown,
+	// but override_special must remain null unless a provider default is set.
+	var overrideSpecial types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("override_special"), &overrideSpecial)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if overrideSpecial.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("override_special"), types.String{Null: true})...)
+	}
+
+	if defaults != nil {
+		applyStringDefaults(ctx, defaults, req, resp)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !policy.IsNull() {
+		applyPasswordPolicy(ctx, policy, req, resp)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var length, minUpper, minLower, minNumeric, minSpecial types.Int64
+
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("length"), &length)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("min_upper"), &minUpper)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("min_lower"), &minLower)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("min_numeric"), &minNumeric)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("min_special"), &minSpecial)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var config types.Int64
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("length"), &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.IsNull() && policy.IsNull() && (defaults == nil || defaults.Length.IsNull()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("length"),
+			"Missing Length",
+			"The length attribute must be set either in the resource configuration or in the "+
+				"provider defaults block.",
+		)
+		return
+	}
+
+	var characterClasses []characterClassModel
+
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("character_class"), &characterClasses)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	classMins, known := validateCharacterClasses(characterClasses, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if known && !length.IsUnknown() && !minUpper.IsUnknown() && !minLower.IsUnknown() && !minNumeric.IsUnknown() && !minSpecial.IsUnknown() {
+		sumOfMins := minUpper.Value + minLower.Value + minNumeric.Value + minSpecial.Value + classMins
+
+		if length.Value < sumOfMins {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("length"),
+				"Invalid Length",
+				fmt.Sprintf("The length must be at least the sum of min_upper, min_lower, min_numeric, "+
+					"min_special and the min of each character_class (%d), got: %d.", sumOfMins, length.Value),
+			)
+			return
+		}
+	}
+
+	if !policy.IsNull() && !policy.IsUnknown() {
+		validatePasswordPolicy(ctx, policy.Value, resp)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	params, known, diags := stringParams(ctx, resp.Plan)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if known {
+		validateStringParams(params, &resp.Diagnostics)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	modifyEntropyBitsPlan(ctx, params, known, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// State is null when the resource is being created.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	for _, name := range stringReplaceAttributes {
+		var planValue, stateValue, configValue attr.Value
+
+		p := path.Root(name)
+
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, p, &planValue)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, p, &stateValue)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, p, &configValue)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if planValue.Equal(stateValue) {
+			continue
+		}
+
+		// Versions prior to the introduction of character_class blocks store
+		// a null list, which is equivalent to configuring no blocks.
+		if name == "character_class" && isEmptyList(planValue) && isEmptyList(stateValue) {
+			continue
+		}
+
+		// A null mode is equivalent to the random mode.
+		if name == "mode" && isRandomMode(planValue) && isRandomMode(stateValue) {
+			continue
+		}
+
+		// Version 3.4.2 errantly stored an empty string for override_special
+		// when it was not configured, so this change should not replace.
+		if name == "override_special" && configValue.IsNull() {
+			requiresReplace, diags := planmodifiers.RequiresReplaceUnlessEmptyStringToNull()(ctx, stateValue, configValue, p)
+
+			resp.Diagnostics.Append(diags...)
+
+			if !requiresReplace {
+				continue
+			}
+		}
+
+		resp.RequiresReplace = append(resp.RequiresReplace, p)
+	}
+}
+
+// applyStringDefaults sets the planned value of each attribute which is null in the
+// resource configuration to the provider default, if one has been configured.
+func applyStringDefaults(ctx context.Context, defaults *stringDefaultsModel, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	attributeDefaults := map[string]attr.Value{
+		"length":           defaults.Length,
+		"special":          defaults.Special,
+		"upper":            defaults.Upper,
+		"lower":            defaults.Lower,
+		"min_numeric":      defaults.MinNumeric,
+		"min_upper":        defaults.MinUpper,
+		"min_lower":        defaults.MinLower,
+		"min_special":      defaults.MinSpecial,
+		"override_special": defaults.OverrideSpecial,
+	}
+
+	for name, defaultValue := range attributeDefaults {
+		if defaultValue.IsNull() {
+			continue
+		}
+
+		var configValue attr.Value
+
+		p := path.Root(name)
+
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, p, &configValue)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !configValue.IsNull() {
+			continue
+		}
+
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, p, defaultValue)...)
+	}
+
+	if defaults.Numeric.IsNull() {
+		return
+	}
+
+	// The numeric default is only used when neither numeric, nor the deprecated
+	// number attribute, has been configured.
+	var number, numeric types.Bool
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("number"), &number)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("numeric"), &numeric)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !number.IsNull() || !numeric.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("number"), defaults.Numeric)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("numeric"), defaults.Numeric)...)
+}
+
+// The modes of the random_password and random_string resources.
+const (
+	stringModeRandom        = "random"
+	stringModePronounceable = "pronounceable"
+)
+
+// isRandomMode returns whether v is a mode value which generates random results.
+func isRandomMode(v attr.Value) bool {
+	mode, ok := v.(types.String)
+
+	return ok && !mode.IsUnknown() && (mode.IsNull() || mode.Value == stringModeRandom)
+}
+
+// modeAttribute returns the mode attribute of the random_password and random_string resources.
+func modeAttribute() tfsdk.Attribute {
+	return tfsdk.Attribute{
+		Description: fmt.Sprintf("How the result is generated, either `%s` or `%s`. In `%s` mode, the result is "+
+			"made of alternating consonants (`%s`) and vowels (`%s`), which are easy to read aloud, followed by "+
+			"`min_numeric` digits. Only `length` and `min_numeric` are used, and the other `min_*` attributes, "+
+			"`override_special`, `character_class`, `exclude_characters` and `exclude_similar` must not be set. "+
+			"The entropy of a pronounceable result is much lower than that of a random result of the same "+
+			"length, as reported by `entropy_bits`. Default value is `%s`.",
+			stringModeRandom, stringModePronounceable, stringModePronounceable, random.PronounceableConsonants,
+			random.PronounceableVowels, stringModeRandom),
+		Type:     types.StringType,
+		Optional: true,
+		Validators: []tfsdk.AttributeValidator{
+			stringvalidator.OneOf(stringModeRandom, stringModePronounceable),
+		},
+	}
+}
+
+// characterClassBlock returns the character_class block of the random_password and
+// random_string resources.
+func characterClassBlock() tfsdk.Block {
+	return tfsdk.Block{
+		Description: "A custom class of characters which may be used in the result, in addition to " +
+			"the characters enabled by `upper`, `lower`, `numeric` and `special`. Characters " +
+			"belonging to more than one class count towards the `min` and `max` of each.",
+		NestingMode: tfsdk.BlockNestingModeList,
+		Attributes: map[string]tfsdk.Attribute{
+			"name": {
+				Description: "A unique name for the class, used in error messages.",
+				Type:        types.StringType,
+				Required:    true,
+			},
+			"characters": {
+				Description: "The characters belonging to the class.",
+				Type:        types.StringType,
+				Required:    true,
+				Validators: []tfsdk.AttributeValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"min": {
+				Description: "Minimum number of characters from the class in the result. Default value is 0.",
+				Type:        types.Int64Type,
+				Optional:    true,
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max": {
+				Description: "Maximum number of characters from the class in the result. When not set, " +
+					"there is no maximum. When every character which may be used in the result belongs to a class " +
+					"with a maximum, `length` must not exceed the sum of those maximums.",
+				Type:     types.Int64Type,
+				Optional: true,
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+type characterClassModel struct {
+	Name       types.String `tfsdk:"name"`
+	Characters types.String `tfsdk:"characters"`
+	Min        types.Int64  `tfsdk:"min"`
+	Max        types.Int64  `tfsdk:"max"`
+}
+
+// validateCharacterClasses adds an error diagnostic for each character_class block with
+// a duplicate name, or with a min greater than its max. It returns the sum of the min of
+// each block, and whether all of them are known.
+func validateCharacterClasses(classes []characterClassModel, diags *diag.Diagnostics) (int64, bool) {
+	var sumOfMins int64
+
+	known := true
+	names := make(map[string]bool, len(classes))
+
+	for i, class := range classes {
+		p := path.Root("character_class").AtListIndex(i)
+
+		if !class.Name.IsUnknown() && !class.Name.IsNull() {
+			if names[class.Name.Value] {
+				diags.AddAttributeError(
+					p.AtName("name"),
+					"Duplicate Character Class Name",
+					fmt.Sprintf("Each character_class block must have a unique name, got duplicate: %q.", class.Name.Value),
+				)
+			}
+
+			names[class.Name.Value] = true
+		}
+
+		if class.Min.IsUnknown() || class.Max.IsUnknown() {
+			known = false
+			continue
+		}
+
+		if !class.Max.IsNull() && class.Min.Value > class.Max.Value {
+			diags.AddAttributeError(
+				p.AtName("min"),
+				"Invalid Character Class",
+				fmt.Sprintf("The min of a character_class must not exceed its max (%d), got: %d.", class.Max.Value, class.Min.Value),
+			)
+		}
+
+		sumOfMins += class.Min.Value
+	}
+
+	return sumOfMins, known
+}
+
+// characterClasses converts character_class blocks into the character classes used to
+// generate a string.
+func characterClasses(classes []characterClassModel) []random.CharacterClass {
+	var result []random.CharacterClass
+
+	for _, class := range classes {
+		result = append(result, random.CharacterClass{
+			Name:       class.Name.Value,
+			Characters: class.Characters.Value,
+			Min:        class.Min.Value,
+			Max:        class.Max.Value,
+		})
+	}
+
+	return result
+}
+
+// isEmptyList returns whether v is a null list or a list with no elements.
+func isEmptyList(v attr.Value) bool {
+	list, ok := v.(types.List)
+
+	return ok && !list.IsUnknown() && len(list.Elems) == 0
+}
+
+// modifyEntropyBitsPlan sets the planned value of entropy_bits when the resource is being
+// created, and adds an error diagnostic if it is less than min_entropy_bits. entropy_bits is
+// unknown if the planned params are not known. As every attribute from which
+// entropy_bits is derived requires replacement, existing resources keep the value in state.
+func modifyEntropyBitsPlan(ctx context.Context, params random.StringParams, known bool, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	entropyBits := types.Float64{Unknown: true}
+
+	if known {
+		entropyBits = types.Float64{Value: params.EntropyBits()}
+	}
+
+	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("entropy_bits"), entropyBits)...)
+	} else {
+		var stateEntropyBits types.Float64
+
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("entropy_bits"), &stateEntropyBits)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("entropy_bits"), stateEntropyBits)...)
+	}
+
+	var minEntropyBits types.Float64
+
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("min_entropy_bits"), &minEntropyBits)...)
+
+	if resp.Diagnostics.HasError() || entropyBits.IsUnknown() || minEntropyBits.IsNull() || minEntropyBits.IsUnknown() {
+		return
+	}
+
+	if entropyBits.Value < minEntropyBits.Value {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("min_entropy_bits"),
+			"Insufficient Entropy",
+			fmt.Sprintf("The estimated entropy of the result (%.2f bits) is less than min_entropy_bits (%g bits). "+
+				"Increase length, or allow more characters to be used in the result.", entropyBits.Value, minEntropyBits.Value),
+		)
+	}
+}
+
+// readStringState sets entropy_bits and byte_length in the state of random_password and
+// random_string resources which were created before the attributes were introduced.
+func readStringState(ctx context.Context, resp *resource.ReadResponse) {
+	var byteLength types.Int64
+	var entropyBits types.Float64
+	var result types.String
+
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("byte_length"), &byteLength)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("entropy_bits"), &entropyBits)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("result"), &result)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if byteLength.IsNull() && !result.IsNull() && !result.IsUnknown() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("byte_length"), types.Int64{Value: int64(len(result.Value))})...)
+	}
+
+	if !entropyBits.IsNull() {
+		return
+	}
+
+	params, known, diags := stringParams(ctx, resp.State)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || !known {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("entropy_bits"), types.Float64{Value: params.EntropyBits()})...)
+}
+
+// attributeGetter is implemented by tfsdk.Config, tfsdk.Plan and tfsdk.State.
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+}
+
+// stringParams returns the parameters used to generate the result of a random_password or
+// random_string resource, and whether all of them are known.
+func stringParams(ctx context.Context, data attributeGetter) (random.StringParams, bool, diag.Diagnostics) {
+	var (
+		length, minUpper, minLower, minNumeric, minSpecial types.Int64
+		upper, lower, numeric, special                     types.Bool
+		excludeSimilar                                     types.Bool
+		overrideSpecial, excludeCharacters, mode           types.String
+		classes                                            []characterClassModel
+		diags                                              diag.Diagnostics
+	)
+
+	diags.Append(data.GetAttribute(ctx, path.Root("length"), &length)...)
+	diags.Append(data.GetAttribute(ctx, path.Root("upper"), &upper)...)
+	diags.Append(data.GetAttribute(ctx, path.Root("min_upper"), &minUpper)...)
+	diags.Append(data.GetAttribute(ctx, path.Root("lower"), &lower)...)
+	diags.Append(data.GetAttribute(ctx, path.Root("min_lower"), &minLower)...)
+	diags.Append(data.GetAttribute(ctx, path.Root("numeric"), &numeric)...)
+	diags.Append(data.GetAttribute(ctx, path.Root("min_numeric"), &minNumeric)...)
+	diags.Append(data.GetAttribute(ctx, path.Root("special"), &special)...)
+	diags.Append(data.GetAttribute(ctx, path.Root("min_special"), &minSpecial)...)
+	diags.Append(data.GetAttribute(ctx, path.Root("override_special"), &overrideSpecial)...)
+	diags.Append(data.GetAttribute(ctx, path.Root("character_class"), &classes)...)
+	diags.Append(data.GetAttribute(ctx, path.Root("exclude_characters"), &excludeCharacters)...)
+	diags.Append(data.GetAttribute(ctx, path.Root("exclude_similar"), &excludeSimilar)...)
+	diags.Append(data.GetAttribute(ctx, path.Root("mode"), &mode)...)
+
+	if diags.HasError() {
+		return random.StringParams{}, false, diags
+	}
+
+	values := []attr.Value{
+		length, upper, minUpper, lower, minLower, numeric, minNumeric, special, minSpecial, overrideSpecial,
+		excludeCharacters, excludeSimilar, mode,
+	}
+
+	for _, class := range classes {
+		values = append(values, class.Characters, class.Min, class.Max)
+	}
+
+	for _, value := range values {
+		if value.IsUnknown() {
+			return random.StringParams{}, false, diags
+		}
+	}
+
+	params := random.StringParams{
+		Length:            length.Value,
+		Upper:             upper.Value,
+		MinUpper:          minUpper.Value,
+		Lower:             lower.Value,
+		MinLower:          minLower.Value,
+		Numeric:           numeric.Value,
+		MinNumeric:        minNumeric.Value,
+		Special:           special.Value,
+		MinSpecial:        minSpecial.Value,
+		OverrideSpecial:   overrideSpecial.Value,
+		CharacterClasses:  characterClasses(classes),
+		ExcludeCharacters: excludeCharacters.Value,
+		ExcludeSimilar:    excludeSimilar.Value,
+		Pronounceable:     mode.Value == stringModePronounceable,
+	}
+
+	return params, true, diags
+}
+
+// validateStringParams adds an error diagnostic for each character class with a minimum, all
+// of the characters of which are excluded, and if no characters may be used in the result
+// beyond those satisfying the minimums.
+func validateStringParams(params random.StringParams, diags *diag.Diagnostics) {
+	if params.Pronounceable {
+		validatePronounceableParams(params, diags)
+		return
+	}
+
+	// The paths of the minimum of the numeric, lower, upper and special classes, which
+	// are returned first by Classes.
+	minPaths := []path.Path{
+		path.Root("min_numeric"),
+		path.Root("min_lower"),
+		path.Root("min_upper"),
+		path.Root("min_special"),
+	}
+
+	var mins int64
+
+	for i, class := range params.Classes() {
+		mins += class.Min
+
+		if class.Min == 0 || class.Characters != "" {
+			continue
+		}
+
+		p := path.Root("character_class").AtListIndex(i - len(minPaths)).AtName("min")
+
+		if i < len(minPaths) {
+			p = minPaths[i]
+		}
+
+		diags.AddAttributeError(
+			p,
+			"Excluded Character Class",
+			fmt.Sprintf("Every character of the %s character class is excluded by exclude_characters or "+
+				"exclude_similar, so its minimum of %d cannot be satisfied.", class.Name, class.Min),
+		)
+	}
+
+	if params.Length > mins && len(params.Alphabet()) == 0 {
+		diags.AddAttributeError(
+			path.Root("length"),
+			"No Characters Available",
+			"Every character which may be used in the result is either disabled or excluded by "+
+				"exclude_characters or exclude_similar.",
+		)
+	} else if maxLength, limited := params.MaxLength(); limited && params.Length > maxLength {
+		diags.AddAttributeError(
+			path.Root("length"),
+			"Invalid Length",
+			fmt.Sprintf("Every character which may be used in the result belongs to a character_class "+
+				"with a max, so the length must not exceed the sum of those max values (%d), got: %d.",
+				maxLength, params.Length),
+		)
+	}
+}
+
+// validatePronounceableParams adds an error diagnostic for each attribute which is set but not used in
+// pronounceable mode.
+func validatePronounceableParams(params random.StringParams, diags *diag.Diagnostics) {
+	unused := []struct {
+		name string
+		set  bool
+	}{
+		{"min_upper", params.MinUpper > 0},
+		{"min_lower", params.MinLower > 0},
+		{"min_special", params.MinSpecial > 0},
+		{"override_special", params.OverrideSpecial != ""},
+		{"character_class", len(params.CharacterClasses) > 0},
+		{"exclude_characters", params.ExcludeCharacters != ""},
+		{"exclude_similar", params.ExcludeSimilar},
+	}
+
+	for _, attribute := range unused {
+		if !attribute.set {
+			continue
+		}
+
+		diags.AddAttributeError(
+			path.Root(attribute.name),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("%s cannot be set when mode is %s, as a pronounceable result is made only of "+
+				"consonants, vowels and min_numeric digits.", attribute.name, stringModePronounceable),
+		)
+	}
+}
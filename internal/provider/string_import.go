@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+// stringImportID is the extended import ID of the random_password and random_string resources, a JSON
+// object of the result and the attributes with which it was generated. Attributes which are omitted
+// take their default value, except for override_special, which is inferred from the characters of the
+// result.
+type stringImportID struct {
+	Result            *string                  `json:"result"`
+	Keepers           map[string]string        `json:"keepers"`
+	Length            *int64                   `json:"length"`
+	Upper             *bool                    `json:"upper"`
+	Lower             *bool                    `json:"lower"`
+	Numeric           *bool                    `json:"numeric"`
+	Special           *bool                    `json:"special"`
+	MinUpper          *int64                   `json:"min_upper"`
+	MinLower          *int64                   `json:"min_lower"`
+	MinNumeric        *int64                   `json:"min_numeric"`
+	MinSpecial        *int64                   `json:"min_special"`
+	OverrideSpecial   *string                  `json:"override_special"`
+	CharacterClasses  []characterClassImportID `json:"character_class"`
+	ExcludeCharacters *string                  `json:"exclude_characters"`
+	ExcludeSimilar    *bool                    `json:"exclude_similar"`
+	Mode              *string                  `json:"mode"`
+}
+
+type characterClassImportID struct {
+	Name       string `json:"name"`
+	Characters string `json:"characters"`
+	Min        *int64 `json:"min"`
+	Max        *int64 `json:"max"`
+}
+
+// stringImport is the state of the attributes of an imported random_password or random_string.
+type stringImport struct {
+	Result            types.String
+	Keepers           types.Map
+	Length            types.Int64
+	ByteLength        types.Int64
+	Upper             types.Bool
+	Lower             types.Bool
+	Numeric           types.Bool
+	Special           types.Bool
+	MinUpper          types.Int64
+	MinLower          types.Int64
+	MinNumeric        types.Int64
+	MinSpecial        types.Int64
+	OverrideSpecial   types.String
+	CharacterClasses  []characterClassModel
+	ExcludeCharacters types.String
+	ExcludeSimilar    types.Bool
+	Mode              types.String
+	EntropyBits       types.Float64
+}
+
+// isStringImportID returns whether id is an extended import ID rather than a result. A result is only
+// treated as an extended import ID if it is a valid JSON object.
+func isStringImportID(id string) bool {
+	return strings.HasPrefix(id, "{") && json.Valid([]byte(id))
+}
+
+// importString returns the state of the attributes of a random_password or random_string imported with
+// id. If id is an extended import ID, an error diagnostic is added if it is invalid, or if its result
+// could not have been generated with its attributes. Otherwise, id is the result, every character class
+// is enabled, and override_special is inferred from its characters.
+func importString(id string, diags *diag.Diagnostics) stringImport {
+	if !isStringImportID(id) {
+		return newStringImport(id, random.InferStringParams(id), stringImportID{})
+	}
+
+	importID, err := parseStringImportID(id)
+	if err != nil {
+		diags.AddError(
+			"Invalid Import ID",
+			"The import ID could not be parsed as a JSON object of the result and the attributes with which it "+
+				"was generated.\n\n"+
+				fmt.Sprintf("Original Error: %s", err),
+		)
+
+		return stringImport{}
+	}
+
+	result := *importID.Result
+	inferred := random.InferStringParams(result)
+
+	params := random.StringParams{
+		Length:            inferred.Length,
+		Upper:             boolOrDefault(importID.Upper, inferred.Upper),
+		Lower:             boolOrDefault(importID.Lower, inferred.Lower),
+		Numeric:           boolOrDefault(importID.Numeric, inferred.Numeric),
+		Special:           boolOrDefault(importID.Special, inferred.Special),
+		MinUpper:          int64OrDefault(importID.MinUpper, 0),
+		MinLower:          int64OrDefault(importID.MinLower, 0),
+		MinNumeric:        int64OrDefault(importID.MinNumeric, 0),
+		MinSpecial:        int64OrDefault(importID.MinSpecial, 0),
+		OverrideSpecial:   stringOrDefault(importID.OverrideSpecial, inferred.OverrideSpecial),
+		ExcludeCharacters: stringOrDefault(importID.ExcludeCharacters, ""),
+		ExcludeSimilar:    boolOrDefault(importID.ExcludeSimilar, false),
+		Pronounceable:     stringOrDefault(importID.Mode, stringModeRandom) == stringModePronounceable,
+	}
+
+	if importID.Length != nil {
+		params.Length = *importID.Length
+	}
+
+	// The enabled classes and override_special are not used in pronounceable mode, so they keep their
+	// default values unless they are set.
+	if params.Pronounceable {
+		params.Upper = boolOrDefault(importID.Upper, true)
+		params.Lower = boolOrDefault(importID.Lower, true)
+		params.Numeric = boolOrDefault(importID.Numeric, true)
+		params.Special = boolOrDefault(importID.Special, true)
+		params.OverrideSpecial = stringOrDefault(importID.OverrideSpecial, "")
+	}
+
+	for _, class := range importID.CharacterClasses {
+		params.CharacterClasses = append(params.CharacterClasses, random.CharacterClass{
+			Name:       class.Name,
+			Characters: class.Characters,
+			Min:        int64OrDefault(class.Min, 0),
+			Max:        int64OrDefault(class.Max, 0),
+		})
+	}
+
+	validateStringParams(params, diags)
+
+	if err := params.Verify(result); err != nil {
+		diags.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("The result could not have been generated with the attributes of the import ID: %s.", err),
+		)
+	}
+
+	return newStringImport(result, params, importID)
+}
+
+// parseStringImportID parses an extended import ID. Unlike the attributes of the resources, it has no
+// keys for min_entropy_bits, the rotation attributes or the attributes of random_password which do not
+// change how the result is generated, so that the import ID only describes the result itself.
+func parseStringImportID(id string) (stringImportID, error) {
+	var importID stringImportID
+
+	decoder := json.NewDecoder(bytes.NewBufferString(id))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&importID); err != nil {
+		return importID, err
+	}
+
+	if importID.Result == nil {
+		return importID, fmt.Errorf("the result key is required")
+	}
+
+	if importID.Mode != nil && *importID.Mode != stringModeRandom && *importID.Mode != stringModePronounceable {
+		return importID, fmt.Errorf("mode must be one of %q or %q, got: %q", stringModeRandom, stringModePronounceable, *importID.Mode)
+	}
+
+	for i, class := range importID.CharacterClasses {
+		if class.Name == "" || class.Characters == "" {
+			return importID, fmt.Errorf("character_class %d must have a name and characters", i)
+		}
+	}
+
+	return importID, nil
+}
+
+// newStringImport returns the state of the attributes of a result generated with params. Attributes
+// which are not set in importID, and which do not have a default value, are null.
+func newStringImport(result string, params random.StringParams, importID stringImportID) stringImport {
+	state := stringImport{
+		Result:            types.String{Value: result},
+		Keepers:           importKeepers(importID.Keepers),
+		Length:            types.Int64{Value: params.Length},
+		ByteLength:        types.Int64{Value: int64(len(result))},
+		Upper:             types.Bool{Value: params.Upper},
+		Lower:             types.Bool{Value: params.Lower},
+		Numeric:           types.Bool{Value: params.Numeric},
+		Special:           types.Bool{Value: params.Special},
+		MinUpper:          types.Int64{Value: params.MinUpper},
+		MinLower:          types.Int64{Value: params.MinLower},
+		MinNumeric:        types.Int64{Value: params.MinNumeric},
+		MinSpecial:        types.Int64{Value: params.MinSpecial},
+		OverrideSpecial:   types.String{Null: params.OverrideSpecial == "", Value: params.OverrideSpecial},
+		ExcludeCharacters: types.String{Null: importID.ExcludeCharacters == nil},
+		ExcludeSimilar:    types.Bool{Null: importID.ExcludeSimilar == nil},
+		Mode:              types.String{Null: importID.Mode == nil},
+		EntropyBits:       types.Float64{Value: params.EntropyBits()},
+	}
+
+	if importID.ExcludeCharacters != nil {
+		state.ExcludeCharacters.Value = *importID.ExcludeCharacters
+	}
+
+	if importID.ExcludeSimilar != nil {
+		state.ExcludeSimilar.Value = *importID.ExcludeSimilar
+	}
+
+	if importID.Mode != nil {
+		state.Mode.Value = *importID.Mode
+	}
+
+	for i, class := range importID.CharacterClasses {
+		state.CharacterClasses = append(state.CharacterClasses, characterClassModel{
+			Name:       types.String{Value: class.Name},
+			Characters: types.String{Value: class.Characters},
+			Min:        types.Int64{Null: class.Min == nil, Value: params.CharacterClasses[i].Min},
+			Max:        types.Int64{Null: class.Max == nil, Value: params.CharacterClasses[i].Max},
+		})
+	}
+
+	return state
+}
+
+func boolOrDefault(value *bool, def bool) bool {
+	if value == nil {
+		return def
+	}
+
+	return *value
+}
+
+func int64OrDefault(value *int64, def int64) int64 {
+	if value == nil {
+		return def
+	}
+
+	return *value
+}
+
+func stringOrDefault(value *string, def string) string {
+	if value == nil {
+		return def
+	}
+
+	return *value
+}
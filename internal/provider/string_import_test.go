@@ -0,0 +1,311 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestImportString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id            string
+		expected      stringImport
+		expectedError bool
+	}{
+		"result-inferred": {
+			id: "abcdef",
+			expected: stringImport{
+				Result:  types.String{Value: "abcdef"},
+				Length:  types.Int64{Value: 6},
+				Upper:   types.Bool{Value: true},
+				Lower:   types.Bool{Value: true},
+				Numeric: types.Bool{Value: true},
+				Special: types.Bool{Value: true},
+			},
+		},
+		"result-override-special": {
+			id: "ab~c^d",
+			expected: stringImport{
+				Result:          types.String{Value: "ab~c^d"},
+				Length:          types.Int64{Value: 6},
+				Upper:           types.Bool{Value: true},
+				Lower:           types.Bool{Value: true},
+				Numeric:         types.Bool{Value: true},
+				Special:         types.Bool{Value: true},
+				OverrideSpecial: types.String{Value: "^~"},
+			},
+		},
+		"result-not-json": {
+			id: "{abc",
+			expected: stringImport{
+				Result:  types.String{Value: "{abc"},
+				Length:  types.Int64{Value: 4},
+				Upper:   types.Bool{Value: true},
+				Lower:   types.Bool{Value: true},
+				Numeric: types.Bool{Value: true},
+				Special: types.Bool{Value: true},
+			},
+		},
+		"json": {
+			id: `{"result": "aB3def", "upper": true, "lower": true, "numeric": true, "special": false, "min_upper": 1}`,
+			expected: stringImport{
+				Result:   types.String{Value: "aB3def"},
+				Length:   types.Int64{Value: 6},
+				Upper:    types.Bool{Value: true},
+				Lower:    types.Bool{Value: true},
+				Numeric:  types.Bool{Value: true},
+				Special:  types.Bool{Value: false},
+				MinUpper: types.Int64{Value: 1},
+			},
+		},
+		"json-inferred": {
+			id: `{"result": "abcdef", "numeric": false}`,
+			expected: stringImport{
+				Result:  types.String{Value: "abcdef"},
+				Length:  types.Int64{Value: 6},
+				Upper:   types.Bool{Value: true},
+				Lower:   types.Bool{Value: true},
+				Numeric: types.Bool{Value: false},
+				Special: types.Bool{Value: true},
+			},
+		},
+		"json-override-special": {
+			id: `{"result": "ab~c^d", "upper": false}`,
+			expected: stringImport{
+				Result:          types.String{Value: "ab~c^d"},
+				Length:          types.Int64{Value: 6},
+				Upper:           types.Bool{Value: false},
+				Lower:           types.Bool{Value: true},
+				Numeric:         types.Bool{Value: true},
+				Special:         types.Bool{Value: true},
+				OverrideSpecial: types.String{Value: "^~"},
+			},
+		},
+		"json-pronounceable": {
+			id: `{"result": "bakiro12", "mode": "pronounceable", "min_numeric": 2}`,
+			expected: stringImport{
+				Result:     types.String{Value: "bakiro12"},
+				Length:     types.Int64{Value: 8},
+				Upper:      types.Bool{Value: true},
+				Lower:      types.Bool{Value: true},
+				Numeric:    types.Bool{Value: true},
+				Special:    types.Bool{Value: true},
+				MinNumeric: types.Int64{Value: 2},
+				Mode:       types.String{Value: stringModePronounceable},
+			},
+		},
+		"json-length-mismatch": {
+			id:            `{"result": "abcdef", "length": 8}`,
+			expectedError: true,
+		},
+		"json-min-not-satisfied": {
+			id:            `{"result": "abcdef", "numeric": true, "min_numeric": 1}`,
+			expectedError: true,
+		},
+		"json-class-disabled": {
+			id:            `{"result": "aBcdef", "upper": false}`,
+			expectedError: true,
+		},
+		"json-unknown-key": {
+			id:            `{"result": "abcdef", "bcrypt_cost": 12}`,
+			expectedError: true,
+		},
+		"json-result-missing": {
+			id:            `{"length": 6}`,
+			expectedError: true,
+		},
+		"json-invalid-mode": {
+			id:            `{"result": "abcdef", "mode": "memorable"}`,
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			got := importString(testCase.id, &diags)
+
+			if testCase.expectedError {
+				if !diags.HasError() {
+					t.Errorf("expected error, got: %+v", got)
+				}
+
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %s", diags)
+			}
+
+			checks := []struct {
+				name          string
+				got, expected fmt.Stringer
+			}{
+				{"result", got.Result, testCase.expected.Result},
+				{"length", got.Length, testCase.expected.Length},
+				{"upper", got.Upper, testCase.expected.Upper},
+				{"lower", got.Lower, testCase.expected.Lower},
+				{"numeric", got.Numeric, testCase.expected.Numeric},
+				{"special", got.Special, testCase.expected.Special},
+				{"min_upper", got.MinUpper, testCase.expected.MinUpper},
+				{"min_numeric", got.MinNumeric, testCase.expected.MinNumeric},
+			}
+
+			for _, check := range checks {
+				if check.got.String() != check.expected.String() {
+					t.Errorf("expected %s %s, got: %s", check.name, check.expected, check.got)
+				}
+			}
+
+			if got.OverrideSpecial.Value != testCase.expected.OverrideSpecial.Value {
+				t.Errorf("expected override_special %s, got: %s", testCase.expected.OverrideSpecial, got.OverrideSpecial)
+			}
+
+			if testCase.expected.Mode.Value != got.Mode.Value {
+				t.Errorf("expected mode %s, got: %s", testCase.expected.Mode, got.Mode)
+			}
+		})
+	}
+}
+
+func TestAccResourceString_Import_Inferred(t *testing.T) {
+	var result1, result2 string
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "test" {
+							length  = 12
+							upper   = false
+							numeric = false
+							special = false
+						}`,
+				ResourceName:       "random_string.test",
+				ImportState:        true,
+				ImportStateId:      "xkcdzvmqplrt",
+				ImportStatePersist: true,
+				ImportStateCheck: composeImportStateCheck(
+					testExtractResourceAttrInstanceState("result", &result1),
+				),
+			},
+			{
+				Config: `resource "random_string" "test" {
+							length  = 12
+							upper   = false
+							numeric = false
+							special = false
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testExtractResourceAttr("random_string.test", "result", &result2),
+					testCheckAttributeValuesEqual(&result1, &result2),
+					resource.TestCheckResourceAttr("random_string.test", "upper", "false"),
+					resource.TestCheckResourceAttr("random_string.test", "lower", "true"),
+					resource.TestCheckResourceAttr("random_string.test", "numeric", "false"),
+					resource.TestCheckResourceAttr("random_string.test", "special", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_Import_JSON(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "test" {
+							length    = 12
+							special   = false
+							min_upper = 2
+							keepers = {
+								env = "prod"
+							}
+						}`,
+			},
+			{
+				ResourceName: "random_string.test",
+				ImportStateIdFunc: testStringImportIDFunc("random_string.test",
+					`"upper": true, "lower": true, "numeric": true, "special": false, "min_upper": 2, "keepers": {"env": "prod"}`),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"created_at"},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_Import_JSON(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length           = 12
+							override_special = "!#"
+							min_special      = 1
+							exclude_similar  = true
+						}`,
+			},
+			{
+				ResourceName: "random_password.test",
+				ImportStateIdFunc: testStringImportIDFunc("random_password.test",
+					`"upper": true, "lower": true, "numeric": true, "special": true, "override_special": "!#", "min_special": 1, "exclude_similar": true`),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bcrypt_hash", "created_at", "hashes"},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_Import_JSON_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length = 6
+						}`,
+				ResourceName:  "random_password.test",
+				ImportState:   true,
+				ImportStateId: `{"result": "abcdef", "numeric": true, "min_numeric": 1}`,
+				ExpectError:   regexp.MustCompile(`at least 1 characters of the numeric character class`),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length = 6
+						}`,
+				ResourceName:  "random_password.test",
+				ImportState:   true,
+				ImportStateId: `{"result": "abcdef", "policy": "aws_iam"}`,
+				ExpectError:   regexp.MustCompile(`unknown field "policy"`),
+			},
+		},
+	})
+}
+
+// testStringImportIDFunc returns the extended import ID of the result of a random_password or random_string,
+// with the JSON object members given in attributes.
+func testStringImportIDFunc(resourceName, attributes string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf(`{"result": %q, %s}`, rs.Primary.Attributes["result"], attributes), nil
+	}
+}
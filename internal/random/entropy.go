@@ -0,0 +1,94 @@
+package random
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	mathrand "math/rand"
+	"os"
+	"sync"
+)
+
+// ErrInsufficientEntropy is returned when an EntropySource is exhausted before
+// the requested number of bytes could be read.
+var ErrInsufficientEntropy = errors.New("entropy source returned an insufficient number of bytes")
+
+// EntropySource is a source of random bytes from which all resource values are
+// generated.
+type EntropySource interface {
+	io.Reader
+}
+
+// NewCryptoEntropySource returns an EntropySource which reads from the
+// cryptographically secure random number generator of the operating system.
+func NewCryptoEntropySource() EntropySource {
+	return rand.Reader
+}
+
+// NewFileEntropySource returns an EntropySource which reads from the file at
+// path, for instance a device or a named pipe (FIFO) fed by an external source
+// of randomness.
+//
+// The file is opened on first use and then read sequentially, so that no bytes
+// are ever used twice. Reaching the end of the file results in an error
+// wrapping ErrInsufficientEntropy.
+func NewFileEntropySource(path string) EntropySource {
+	return &fileEntropySource{
+		path: path,
+	}
+}
+
+type fileEntropySource struct {
+	path string
+
+	mu   sync.Mutex
+	file *os.File
+}
+
+func (s *fileEntropySource) Read(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		file, err := os.Open(s.path)
+		if err != nil {
+			return 0, err
+		}
+
+		s.file = file
+	}
+
+	n, err := s.file.Read(p)
+
+	if errors.Is(err, io.EOF) {
+		return n, fmt.Errorf("%w: end of file %s", ErrInsufficientEntropy, s.path)
+	}
+
+	return n, err
+}
+
+// ReadEntropy fills b with bytes read from source. The returned error wraps
+// ErrInsufficientEntropy if the source is exhausted before b is filled.
+func ReadEntropy(source EntropySource, b []byte) error {
+	_, err := io.ReadFull(source, b)
+
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return fmt.Errorf("%w: %s", ErrInsufficientEntropy, err)
+	}
+
+	return err
+}
+
+// NewRandFromEntropy returns a random number generator seeded with bytes read
+// from source.
+func NewRandFromEntropy(source EntropySource) (*mathrand.Rand, error) {
+	var seed [8]byte
+
+	if err := ReadEntropy(source, seed[:]); err != nil {
+		return nil, err
+	}
+
+	return mathrand.New(mathrand.NewSource(int64(binary.BigEndian.Uint64(seed[:])))), nil
+}
@@ -0,0 +1,72 @@
+package random
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileEntropySource(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "entropy")
+
+	if err := os.WriteFile(path, []byte("0123456789"), 0600); err != nil {
+		t.Fatalf("unexpected error writing file: %s", err)
+	}
+
+	source := NewFileEntropySource(path)
+
+	first := make([]byte, 4)
+	if err := ReadEntropy(source, first); err != nil {
+		t.Fatalf("unexpected error reading entropy: %s", err)
+	}
+
+	second := make([]byte, 4)
+	if err := ReadEntropy(source, second); err != nil {
+		t.Fatalf("unexpected error reading entropy: %s", err)
+	}
+
+	if !bytes.Equal(first, []byte("0123")) || !bytes.Equal(second, []byte("4567")) {
+		t.Errorf("expected file to be read sequentially, got: %q, %q", first, second)
+	}
+
+	third := make([]byte, 4)
+	if err := ReadEntropy(source, third); !errors.Is(err, ErrInsufficientEntropy) {
+		t.Errorf("expected ErrInsufficientEntropy, got: %v", err)
+	}
+}
+
+func TestCreateString_EntropySource(t *testing.T) {
+	t.Parallel()
+
+	params := StringParams{
+		Length:     32,
+		Upper:      true,
+		Lower:      true,
+		Numeric:    true,
+		Special:    true,
+		MinNumeric: 4,
+	}
+
+	first, err := CreateString(NewReproducibleEntropySource("seed"), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	second, err := CreateString(NewReproducibleEntropySource("seed"), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !bytes.Equal(first, second) {
+		t.Errorf("expected identical sources to produce identical strings, got: %q, %q", first, second)
+	}
+
+	_, err = CreateString(bytes.NewReader(make([]byte, 8)), params)
+	if err == nil {
+		t.Error("expected error from exhausted source")
+	}
+}
@@ -0,0 +1,126 @@
+package random
+
+import (
+	"crypto/rand"
+	_ "embed"
+	"math"
+	"math/big"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// effLargeWordListFile is the EFF large word list, in which each line is the
+// five dice rolls which select a word, followed by a tab and the word.
+//
+// The word list is published by the Electronic Frontier Foundation under the
+// Creative Commons Attribution 3.0 United States license:
+// https://www.eff.org/deeplinks/2016/07/new-wordlists-random-passphrases
+//
+//go:embed eff_large_wordlist.txt
+var effLargeWordListFile string
+
+// EFFLargeWordList are the 7776 words of the EFF large word list, from which
+// passphrases are generated.
+var EFFLargeWordList = parseWordList(effLargeWordListFile)
+
+// effLargeWordSet and effLargeWordListMaxLen are the words of
+// EFFLargeWordList and the length of the longest, with which passphrases are
+// verified.
+var effLargeWordSet, effLargeWordListMaxLen = wordSet(EFFLargeWordList)
+
+// PassphraseParams are the parameters from which a passphrase is generated.
+type PassphraseParams struct {
+	// Words is the number of words in the passphrase.
+	Words int64
+
+	// Separator is placed between each word of the passphrase.
+	Separator string
+
+	// Capitalize makes the first letter of each word upper case.
+	Capitalize bool
+
+	// IncludeNumber appends a random digit to a randomly chosen word.
+	IncludeNumber bool
+}
+
+// CreatePassphrase returns a passphrase of words chosen uniformly at random
+// from EFFLargeWordList.
+func CreatePassphrase(source EntropySource, params PassphraseParams) (string, error) {
+	words := make([]string, params.Words)
+
+	for i := range words {
+		idx, err := rand.Int(source, big.NewInt(int64(len(EFFLargeWordList))))
+		if err != nil {
+			return "", err
+		}
+
+		words[i] = EFFLargeWordList[idx.Int64()]
+
+		if params.Capitalize {
+			words[i] = capitalize(words[i])
+		}
+	}
+
+	if params.IncludeNumber && len(words) > 0 {
+		idx, err := rand.Int(source, big.NewInt(int64(len(words))))
+		if err != nil {
+			return "", err
+		}
+
+		digit, err := randomChar(source, []rune(NumericChars))
+		if err != nil {
+			return "", err
+		}
+
+		words[idx.Int64()] += string(digit)
+	}
+
+	return strings.Join(words, params.Separator), nil
+}
+
+// EntropyBits returns the entropy of a passphrase generated with the params,
+// in bits.
+func (p PassphraseParams) EntropyBits() float64 {
+	bits := float64(p.Words) * math.Log2(float64(len(EFFLargeWordList)))
+
+	if p.IncludeNumber && p.Words > 0 {
+		bits += math.Log2(float64(len(NumericChars))) + math.Log2(float64(p.Words))
+	}
+
+	return bits
+}
+
+func capitalize(word string) string {
+	r, size := utf8.DecodeRuneInString(word)
+
+	return string(unicode.ToUpper(r)) + word[size:]
+}
+
+func parseWordList(list string) []string {
+	lines := strings.Split(strings.TrimSpace(list), "\n")
+	words := make([]string, 0, len(lines))
+
+	for _, line := range lines {
+		fields := strings.Fields(line)
+
+		words = append(words, fields[len(fields)-1])
+	}
+
+	return words
+}
+
+func wordSet(words []string) (map[string]struct{}, int) {
+	set := make(map[string]struct{}, len(words))
+	maxLen := 0
+
+	for _, word := range words {
+		set[word] = struct{}{}
+
+		if len(word) > maxLen {
+			maxLen = len(word)
+		}
+	}
+
+	return set, maxLen
+}
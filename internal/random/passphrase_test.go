@@ -0,0 +1,115 @@
+package random
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestEFFLargeWordList(t *testing.T) {
+	t.Parallel()
+
+	if len(EFFLargeWordList) != 7776 {
+		t.Fatalf("expected 7776 words, got: %d", len(EFFLargeWordList))
+	}
+
+	if EFFLargeWordList[0] != "abacus" || EFFLargeWordList[7775] != "zoom" {
+		t.Errorf("expected abacus to zoom, got: %s to %s", EFFLargeWordList[0], EFFLargeWordList[7775])
+	}
+
+	seen := make(map[string]bool, len(EFFLargeWordList))
+
+	for _, word := range EFFLargeWordList {
+		if seen[word] {
+			t.Errorf("duplicate word: %s", word)
+		}
+
+		seen[word] = true
+	}
+}
+
+func TestCreatePassphrase(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		params   PassphraseParams
+		expected *regexp.Regexp
+	}{
+		"words": {
+			params:   PassphraseParams{Words: 6, Separator: " "},
+			expected: regexp.MustCompile(`^[a-z-]+( [a-z-]+){5}$`),
+		},
+		"separator": {
+			params:   PassphraseParams{Words: 4, Separator: "."},
+			expected: regexp.MustCompile(`^[a-z-]+(\.[a-z-]+){3}$`),
+		},
+		"capitalize": {
+			params:   PassphraseParams{Words: 4, Separator: " ", Capitalize: true},
+			expected: regexp.MustCompile(`^[A-Z][a-z-]*( [A-Z][a-z-]*){3}$`),
+		},
+		"include-number": {
+			params:   PassphraseParams{Words: 4, Separator: " ", IncludeNumber: true},
+			expected: regexp.MustCompile(`^[a-z-]+[0-9]?( [a-z-]+[0-9]?){3}$`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			source := NewReproducibleEntropySource("TestCreatePassphrase", name)
+
+			for i := 0; i < 100; i++ {
+				got, err := CreatePassphrase(source, testCase.params)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if !testCase.expected.MatchString(got) {
+					t.Fatalf("expected to match %s, got: %s", testCase.expected, got)
+				}
+
+				if digits := strings.IndexAny(got, NumericChars); testCase.params.IncludeNumber != (digits >= 0) {
+					t.Fatalf("expected number %t, got: %s", testCase.params.IncludeNumber, got)
+				}
+			}
+		})
+	}
+}
+
+func TestPassphraseParams_EntropyBits(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		params   PassphraseParams
+		expected float64
+	}{
+		"words": {
+			params:   PassphraseParams{Words: 6},
+			expected: 77.55,
+		},
+		"include-number": {
+			params:   PassphraseParams{Words: 4, IncludeNumber: true},
+			expected: 57.02,
+		},
+		"capitalize": {
+			params:   PassphraseParams{Words: 6, Capitalize: true},
+			expected: 77.55,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.params.EntropyBits(); math.Abs(got-testCase.expected) > 0.01 {
+				t.Errorf("expected %.2f, got: %.2f", testCase.expected, got)
+			}
+		})
+	}
+}
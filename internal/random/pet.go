@@ -0,0 +1,103 @@
+package random
+
+import (
+	"crypto/rand"
+	_ "embed"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// The pet name word lists are those of the petname project, published by
+// Dustin Kirkland under the Apache License, Version 2.0:
+// https://github.com/dustinkirkland/golang-petname
+//
+// They are embedded rather than used through the petname package, which
+// chooses words from the shared math/rand source.
+var (
+	//go:embed petname_adjectives.txt
+	petnameAdjectivesFile string
+
+	//go:embed petname_adverbs.txt
+	petnameAdverbsFile string
+
+	//go:embed petname_names.txt
+	petnameNamesFile string
+)
+
+var (
+	// PetAdjectives are the adjectives of which pet names are made.
+	PetAdjectives = strings.Fields(petnameAdjectivesFile)
+
+	// PetAdverbs are the adverbs of which pet names are made.
+	PetAdverbs = strings.Fields(petnameAdverbsFile)
+
+	// PetNames are the animal names with which every pet name ends.
+	PetNames = strings.Fields(petnameNamesFile)
+)
+
+// petAdjectiveSet, petAdverbSet and petNameSet are the words of
+// PetAdjectives, PetAdverbs and PetNames, with which pet names are verified.
+var (
+	petAdjectiveSet, _ = wordSet(PetAdjectives)
+	petAdverbSet, _    = wordSet(PetAdverbs)
+	petNameSet, _      = wordSet(PetNames)
+)
+
+// CreatePetName returns a pet name of the given number of words, joined by
+// separator, in the same shape as the petname package: a single word is a
+// name, two words are an adjective and a name, and any further words are
+// adverbs placed before the adjective. Randomness is read from source.
+func CreatePetName(source EntropySource, words int, separator string) (string, error) {
+	var lists [][]string
+
+	for i := 0; i < words-2; i++ {
+		lists = append(lists, PetAdverbs)
+	}
+
+	if words > 1 {
+		lists = append(lists, PetAdjectives)
+	}
+
+	lists = append(lists, PetNames)
+
+	pet := make([]string, len(lists))
+
+	for i, list := range lists {
+		idx, err := rand.Int(source, big.NewInt(int64(len(list))))
+		if err != nil {
+			return "", err
+		}
+
+		pet[i] = list[idx.Int64()]
+	}
+
+	return strings.Join(pet, separator), nil
+}
+
+// VerifyPetName returns an error if words could not have been generated by
+// CreatePetName: if they are not any number of PetAdverbs, followed by one of
+// PetAdjectives when there are two or more words, followed by one of
+// PetNames.
+func VerifyPetName(words []string) error {
+	if len(words) == 0 {
+		return fmt.Errorf("a pet name must have at least one word")
+	}
+
+	for i, word := range words {
+		set, kind := petAdverbSet, "adverbs"
+
+		switch i {
+		case len(words) - 1:
+			set, kind = petNameSet, "names"
+		case len(words) - 2:
+			set, kind = petAdjectiveSet, "adjectives"
+		}
+
+		if _, ok := set[word]; !ok {
+			return fmt.Errorf("the word %q is not one of the pet name %s", word, kind)
+		}
+	}
+
+	return nil
+}
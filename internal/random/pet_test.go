@@ -0,0 +1,134 @@
+package random
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestCreatePetName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		words     int
+		separator string
+		expected  *regexp.Regexp
+	}{
+		"one": {
+			words:     1,
+			separator: "-",
+			expected:  regexp.MustCompile(`^[a-z]+$`),
+		},
+		"two": {
+			words:     2,
+			separator: "-",
+			expected:  regexp.MustCompile(`^[a-z]+-[a-z]+$`),
+		},
+		"four": {
+			words:     4,
+			separator: ".",
+			expected:  regexp.MustCompile(`^[a-z]+(\.[a-z]+){3}$`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			source := NewReproducibleEntropySource("TestCreatePetName", name)
+
+			for i := 0; i < 100; i++ {
+				got, err := CreatePetName(source, testCase.words, testCase.separator)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if !testCase.expected.MatchString(got) {
+					t.Fatalf("expected to match %s, got: %s", testCase.expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestCreatePetName_Reproducible(t *testing.T) {
+	t.Parallel()
+
+	first, err := CreatePetName(NewReproducibleEntropySource("seed"), 3, "-")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	second, err := CreatePetName(NewReproducibleEntropySource("seed"), 3, "-")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if first != second {
+		t.Errorf("expected the same pet name from the same seed, got: %s and %s", first, second)
+	}
+}
+
+func TestVerifyPetName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		words         []string
+		expectedError bool
+	}{
+		"name": {
+			words: []string{"cat"},
+		},
+		"adjective-name": {
+			words: []string{"happy", "cat"},
+		},
+		"adverbs": {
+			words: []string{"really", "truly", "happy", "cat"},
+		},
+		"no-words": {
+			words:         nil,
+			expectedError: true,
+		},
+		"unknown-name": {
+			words:         []string{"happy"},
+			expectedError: true,
+		},
+		"unknown-adjective": {
+			words:         []string{"really", "cat"},
+			expectedError: true,
+		},
+		"unknown-adverb": {
+			words:         []string{"happy", "happy", "cat"},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := VerifyPetName(testCase.words)
+
+			if testCase.expectedError != (err != nil) {
+				t.Errorf("expected error %t, got: %v", testCase.expectedError, err)
+			}
+		})
+	}
+
+	source := NewReproducibleEntropySource("TestVerifyPetName")
+
+	for words := 1; words <= 5; words++ {
+		pet, err := CreatePetName(source, words, "-")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if err := VerifyPetName(strings.Split(pet, "-")); err != nil {
+			t.Errorf("expected %q to verify, got: %s", pet, err)
+		}
+	}
+}
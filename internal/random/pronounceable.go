@@ -0,0 +1,70 @@
+package random
+
+import (
+	"fmt"
+	"math"
+)
+
+// The consonants and vowels of pronounceable strings. Consonants which are
+// easily confused with one another when read aloud, such as c and k, or which
+// are hard to pronounce between vowels, such as q and x, are omitted.
+const (
+	PronounceableConsonants = "bdfghjklmnprstvz"
+	PronounceableVowels     = "aeiou"
+)
+
+// createPronounceableString returns a string of Length characters made of
+// alternating consonants and vowels, starting with a consonant, followed by
+// MinNumeric digits.
+func createPronounceableString(source EntropySource, input StringParams) ([]byte, error) {
+	letters := input.Length - input.MinNumeric
+
+	if letters < 0 {
+		return nil, fmt.Errorf("the length must be at least min_numeric (%d), got: %d", input.MinNumeric, input.Length)
+	}
+
+	result := make([]rune, 0, input.Length)
+
+	for i := int64(0); i < input.Length; i++ {
+		chars := []rune(NumericChars)
+
+		switch {
+		case i >= letters:
+		case i%2 == 0:
+			chars = []rune(PronounceableConsonants)
+		default:
+			chars = []rune(PronounceableVowels)
+		}
+
+		c, err := randomChar(source, chars)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, c)
+	}
+
+	return []byte(string(result)), nil
+}
+
+// pronounceableEntropyBits returns the entropy, in bits, of pronounceable
+// strings generated according to the parameters. As every character is chosen
+// independently from a fixed set for its position, this is exact, and much
+// lower than that of a string of the same length sampled from the character
+// classes. The result is rounded down to two decimal places.
+func pronounceableEntropyBits(p StringParams) float64 {
+	letters := p.Length - p.MinNumeric
+
+	if letters < 0 {
+		return 0
+	}
+
+	consonants := (letters + 1) / 2
+	vowels := letters / 2
+
+	bits := float64(consonants)*math.Log2(float64(len(PronounceableConsonants))) +
+		float64(vowels)*math.Log2(float64(len(PronounceableVowels))) +
+		float64(p.MinNumeric)*math.Log2(float64(len(NumericChars)))
+
+	return math.Floor(bits*100) / 100
+}
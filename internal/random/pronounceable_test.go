@@ -0,0 +1,89 @@
+package random
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestCreateString_Pronounceable(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		params   StringParams
+		expected *regexp.Regexp
+	}{
+		"letters": {
+			params:   StringParams{Length: 9, Pronounceable: true},
+			expected: regexp.MustCompile(`^([bdfghjklmnprstvz][aeiou]){4}[bdfghjklmnprstvz]$`),
+		},
+		"digits": {
+			params:   StringParams{Length: 8, MinNumeric: 2, Pronounceable: true},
+			expected: regexp.MustCompile(`^([bdfghjklmnprstvz][aeiou]){3}[0-9]{2}$`),
+		},
+		"digits-only": {
+			params:   StringParams{Length: 4, MinNumeric: 4, Pronounceable: true},
+			expected: regexp.MustCompile(`^[0-9]{4}$`),
+		},
+		"classes-ignored": {
+			params:   StringParams{Length: 6, Upper: true, Special: true, Numeric: true, Pronounceable: true},
+			expected: regexp.MustCompile(`^([bdfghjklmnprstvz][aeiou]){3}$`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			source := NewReproducibleEntropySource("TestCreateString_Pronounceable", name)
+
+			for i := 0; i < 100; i++ {
+				got, err := CreateString(source, testCase.params)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if !testCase.expected.Match(got) {
+					t.Fatalf("expected to match %s, got: %s", testCase.expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestStringParams_EntropyBits_Pronounceable(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		params   StringParams
+		expected float64
+	}{
+		"letters": {
+			// 5 consonants of 16 and 4 vowels of 5.
+			params:   StringParams{Length: 9, Pronounceable: true},
+			expected: 29.28,
+		},
+		"digits": {
+			// 3 consonants of 16, 3 vowels of 5 and 2 digits of 10.
+			params:   StringParams{Length: 8, MinNumeric: 2, Pronounceable: true},
+			expected: 25.6,
+		},
+		"classes-ignored": {
+			params:   StringParams{Length: 9, Upper: true, Lower: true, Special: true, Pronounceable: true},
+			expected: 29.28,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.params.EntropyBits(); got != testCase.expected {
+				t.Errorf("expected %.2f, got: %.2f", testCase.expected, got)
+			}
+		})
+	}
+}
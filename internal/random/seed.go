@@ -1,11 +1,88 @@
 package random
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
 	"hash/crc64"
+	"math"
 	"math/rand"
 	"time"
+
+	"golang.org/x/crypto/chacha20"
 )
 
+const (
+	// SeedAlgorithmV1 derives a math/rand source from the CRC-64 checksum of
+	// the seed. This was the only algorithm used by provider versions 3.4.3
+	// and earlier.
+	SeedAlgorithmV1 = "v1"
+
+	// SeedAlgorithmV2 uses the ChaCha20 keystream, keyed with the SHA-256
+	// hash of the seed and a zero nonce. Integers are produced by rejection
+	// sampling of big-endian uint64 values read from the stream, and
+	// permutations by a Fisher–Yates shuffle from the last index down.
+	SeedAlgorithmV2 = "v2"
+
+	// LatestSeedAlgorithm is the seed algorithm used for new resources.
+	LatestSeedAlgorithm = SeedAlgorithmV2
+)
+
+// SeedAlgorithms are all supported seed algorithms. The output of each
+// algorithm for a given seed must never change, as results are not
+// regenerated when the provider is upgraded.
+var SeedAlgorithms = []string{
+	SeedAlgorithmV1,
+	SeedAlgorithmV2,
+}
+
+// Generator generates random integers and permutations.
+type Generator interface {
+	// Intn returns a random integer in the range [0, n). It panics if n <= 0.
+	Intn(n int) (int, error)
+
+	// Perm returns a random permutation of the integers [0, n).
+	Perm(n int) ([]int, error)
+}
+
+// NewSeededGenerator returns a Generator for the given seed algorithm, the
+// output of which is entirely determined by the seed.
+func NewSeededGenerator(algorithm string, seed string) (Generator, error) {
+	switch algorithm {
+	case SeedAlgorithmV1:
+		return &mathRandGenerator{NewRand(seed)}, nil
+	case SeedAlgorithmV2:
+		key := sha256.Sum256([]byte(seed))
+
+		cipher, err := chacha20.NewUnauthenticatedCipher(key[:], make([]byte, chacha20.NonceSize))
+		if err != nil {
+			return nil, err
+		}
+
+		return &streamGenerator{&keystreamEntropySource{cipher}}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported seed algorithm: %q", algorithm)
+}
+
+// NewGenerator returns a Generator for the given seed algorithm which reads
+// randomness from source.
+func NewGenerator(algorithm string, source EntropySource) (Generator, error) {
+	switch algorithm {
+	case SeedAlgorithmV1:
+		rand, err := NewRandFromEntropy(source)
+		if err != nil {
+			return nil, err
+		}
+
+		return &mathRandGenerator{rand}, nil
+	case SeedAlgorithmV2:
+		return &streamGenerator{source}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported seed algorithm: %q", algorithm)
+}
+
 // NewRand returns a seeded random number generator, using a seed derived
 // from the provided string.
 //
@@ -22,3 +99,77 @@ func NewRand(seed string) *rand.Rand {
 	randSource := rand.NewSource(seedInt)
 	return rand.New(randSource)
 }
+
+type mathRandGenerator struct {
+	rand *rand.Rand
+}
+
+func (g *mathRandGenerator) Intn(n int) (int, error) {
+	return g.rand.Intn(n), nil
+}
+
+func (g *mathRandGenerator) Perm(n int) ([]int, error) {
+	return g.rand.Perm(n), nil
+}
+
+type streamGenerator struct {
+	source EntropySource
+}
+
+func (g *streamGenerator) Intn(n int) (int, error) {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+
+	max := uint64(n)
+
+	// Values above limit are rejected, so that every result is equally likely.
+	limit := math.MaxUint64 - (math.MaxUint64%max+1)%max
+
+	var b [8]byte
+
+	for {
+		if err := ReadEntropy(g.source, b[:]); err != nil {
+			return 0, err
+		}
+
+		v := binary.BigEndian.Uint64(b[:])
+
+		if v <= limit {
+			return int(v % max), nil
+		}
+	}
+}
+
+func (g *streamGenerator) Perm(n int) ([]int, error) {
+	perm := make([]int, n)
+
+	for i := range perm {
+		perm[i] = i
+	}
+
+	for i := n - 1; i > 0; i-- {
+		j, err := g.Intn(i + 1)
+		if err != nil {
+			return nil, err
+		}
+
+		perm[i], perm[j] = perm[j], perm[i]
+	}
+
+	return perm, nil
+}
+
+type keystreamEntropySource struct {
+	cipher *chacha20.Cipher
+}
+
+func (s *keystreamEntropySource) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+
+	s.cipher.XORKeyStream(p, p)
+
+	return len(p), nil
+}
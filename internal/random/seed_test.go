@@ -0,0 +1,73 @@
+package random
+
+import (
+	"reflect"
+	"testing"
+)
+
+// The results of each seed algorithm must never change, as they are not
+// regenerated when the provider is upgraded.
+func TestNewSeededGenerator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		algorithm    string
+		expectedInt  int
+		expectedPerm []int
+	}{
+		"v1": {
+			algorithm:    SeedAlgorithmV1,
+			expectedInt:  299746,
+			expectedPerm: []int{0, 2, 1, 4, 3},
+		},
+		"v2": {
+			algorithm:    SeedAlgorithmV2,
+			expectedInt:  987370,
+			expectedPerm: []int{1, 3, 4, 0, 2},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			generator, err := NewSeededGenerator(testCase.algorithm, "seed")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := generator.Intn(1000000)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expectedInt {
+				t.Errorf("expected Intn result %d, got: %d", testCase.expectedInt, got)
+			}
+
+			generator, err = NewSeededGenerator(testCase.algorithm, "-")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			perm, err := generator.Perm(5)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(perm, testCase.expectedPerm) {
+				t.Errorf("expected Perm result %v, got: %v", testCase.expectedPerm, perm)
+			}
+		})
+	}
+}
+
+func TestNewSeededGenerator_UnsupportedAlgorithm(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewSeededGenerator("v0", "seed"); err == nil {
+		t.Error("expected error for unsupported seed algorithm")
+	}
+}
@@ -2,8 +2,22 @@ package random
 
 import (
 	"crypto/rand"
+	"fmt"
+	"math"
 	"math/big"
-	"sort"
+	"strings"
+)
+
+// The characters of the numeric, lower, upper and special character classes.
+const (
+	NumericChars = "0123456789"
+	LowerChars   = "abcdefghijklmnopqrstuvwxyz"
+	UpperChars   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	SpecialChars = "!@#$%&*()-_=+[]{}<>:?"
+
+	// SimilarChars are characters which are easily mistaken for one another
+	// when read by a person.
+	SimilarChars = "0O1lI|`"
 )
 
 type StringParams struct {
@@ -17,78 +31,298 @@ type StringParams struct {
 	Special         bool
 	MinSpecial      int64
 	OverrideSpecial string
+
+	// CharacterClasses are additional classes of characters which may be
+	// used in the string, alongside the classes enabled above.
+	CharacterClasses []CharacterClass
+
+	// ExcludeCharacters are removed from every class before the string is
+	// generated. If ExcludeSimilar is true, SimilarChars are also removed.
+	ExcludeCharacters string
+	ExcludeSimilar    bool
+
+	// Pronounceable generates a string of alternating consonants and vowels,
+	// followed by MinNumeric digits, instead of sampling from the character
+	// classes. The other classes and their minimums are not used.
+	Pronounceable bool
+}
+
+// CharacterClass is a named set of characters, at least Min of which must
+// appear in a generated string. If Max is greater than zero, at most Max
+// characters from the set may appear in the string.
+type CharacterClass struct {
+	Name       string
+	Characters string
+	Min        int64
+	Max        int64
+}
+
+// Classes returns every character class which constrains the string: the
+// numeric, lower, upper and special classes, followed by CharacterClasses.
+// Excluded characters are removed from the characters of each class.
+//
+// The minimum of the numeric, lower, upper and special classes applies even
+// when the class is not enabled.
+func (p StringParams) Classes() []CharacterClass {
+	special := SpecialChars
+
+	if p.OverrideSpecial != "" {
+		special = p.OverrideSpecial
+	}
+
+	classes := []CharacterClass{
+		{Name: "numeric", Characters: NumericChars, Min: p.MinNumeric},
+		{Name: "lower", Characters: LowerChars, Min: p.MinLower},
+		{Name: "upper", Characters: UpperChars, Min: p.MinUpper},
+		{Name: "special", Characters: special, Min: p.MinSpecial},
+	}
+
+	classes = append(classes, p.CharacterClasses...)
+
+	excluded := p.ExcludeCharacters
+
+	if p.ExcludeSimilar {
+		excluded += SimilarChars
+	}
+
+	if excluded == "" {
+		return classes
+	}
+
+	for i := range classes {
+		classes[i].Characters = strings.Map(func(r rune) rune {
+			if strings.ContainsRune(excluded, r) {
+				return -1
+			}
+
+			return r
+		}, classes[i].Characters)
+	}
+
+	return classes
 }
 
-func CreateString(input StringParams) ([]byte, error) {
-	const numChars = "0123456789"
-	const lowerChars = "abcdefghijklmnopqrstuvwxyz"
-	const upperChars = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
-	var specialChars = "!@#$%&*()-_=+[]{}<>:?"
-	var result []byte
+// Alphabet returns the distinct characters which may be used in the string,
+// from the enabled numeric, lower, upper and special classes and from
+// CharacterClasses.
+func (p StringParams) Alphabet() []rune {
+	enabled := []bool{p.Numeric, p.Lower, p.Upper, p.Special}
+
+	var chars string
+
+	for i, class := range p.Classes() {
+		if i < len(enabled) && !enabled[i] {
+			continue
+		}
 
-	if input.OverrideSpecial != "" {
-		specialChars = input.OverrideSpecial
+		chars += class.Characters
 	}
 
-	var chars = ""
-	if input.Upper {
-		chars += upperChars
+	return distinct(chars)
+}
+
+// MaxLength returns the greatest length of string which the max of the
+// character classes allows, and false when the length is not limited. A
+// character which belongs to no class with a max may be used any number of
+// times, so the length is only limited when every character which may be
+// used belongs to such a class, and each of those classes then contributes
+// at most its max.
+func (p StringParams) MaxLength() (int64, bool) {
+	classes := p.Classes()
+	chars := p.Alphabet()
+
+	// The minimum of a class is satisfied from the characters of the class,
+	// even when the class is not enabled.
+	for _, class := range classes {
+		if class.Min > 0 {
+			chars = append(chars, []rune(class.Characters)...)
+		}
 	}
-	if input.Lower {
-		chars += lowerChars
+
+	capped := make([]bool, len(classes))
+
+	for _, c := range chars {
+		limited := false
+
+		for i, class := range classes {
+			if class.Max > 0 && strings.ContainsRune(class.Characters, c) {
+				capped[i] = true
+				limited = true
+			}
+		}
+
+		if !limited {
+			return 0, false
+		}
+	}
+
+	var maxLength int64
+
+	for i, class := range classes {
+		if capped[i] {
+			maxLength += class.Max
+		}
 	}
-	if input.Numeric {
-		chars += numChars
+
+	return maxLength, true
+}
+
+// CreateString returns a random string generated according to the supplied parameters, reading
+// randomness from source. The string is encoded as UTF-8, and its length is the number of
+// characters (runes) rather than bytes, so characters of any class may be multi-byte.
+func CreateString(source EntropySource, input StringParams) ([]byte, error) {
+	if input.Pronounceable {
+		return createPronounceableString(source, input)
+	}
+
+	classes := input.Classes()
+	counts := make([]int64, len(classes))
+	result := make([]rune, 0, input.Length)
+
+	// allowed returns the characters in chars which can be added to the
+	// result without exceeding the maximum of any class.
+	allowed := func(chars []rune) []rune {
+		var available []rune
+
+	Chars:
+		for _, c := range chars {
+			for i, class := range classes {
+				if class.Max > 0 && counts[i] >= class.Max && strings.ContainsRune(class.Characters, c) {
+					continue Chars
+				}
+			}
+
+			available = append(available, c)
+		}
+
+		return available
 	}
-	if input.Special {
-		chars += specialChars
+
+	add := func(c rune) {
+		result = append(result, c)
+
+		for i, class := range classes {
+			if strings.ContainsRune(class.Characters, c) {
+				counts[i]++
+			}
+		}
 	}
 
-	minMapping := map[string]int64{
-		numChars:     input.MinNumeric,
-		lowerChars:   input.MinLower,
-		upperChars:   input.MinUpper,
-		specialChars: input.MinSpecial,
+	for _, class := range classes {
+		for i := int64(0); i < class.Min; i++ {
+			chars := allowed(distinct(class.Characters))
+
+			if len(chars) == 0 {
+				return nil, fmt.Errorf("the minimum of the %s character class cannot be satisfied", class.Name)
+			}
+
+			c, err := randomChar(source, chars)
+			if err != nil {
+				return nil, err
+			}
+
+			add(c)
+		}
 	}
 
-	result = make([]byte, 0, input.Length)
+	alphabet := input.Alphabet()
 
-	for k, v := range minMapping {
-		s, err := generateRandomBytes(&k, v)
+	for int64(len(result)) < input.Length {
+		chars := allowed(alphabet)
+
+		if len(chars) == 0 {
+			return nil, fmt.Errorf("no characters are available to generate a string of length %d", input.Length)
+		}
+
+		c, err := randomChar(source, chars)
 		if err != nil {
 			return nil, err
 		}
-		result = append(result, s...)
+
+		add(c)
 	}
 
-	s, err := generateRandomBytes(&chars, input.Length-int64(len(result)))
-	if err != nil {
+	if err := shuffle(source, result); err != nil {
 		return nil, err
 	}
 
-	result = append(result, s...)
+	return []byte(string(result)), nil
+}
 
-	order := make([]byte, len(result))
-	if _, err := rand.Read(order); err != nil {
-		return nil, err
+// shuffle permutes r in place using a Fisher–Yates shuffle, so that every
+// permutation is equally likely.
+func shuffle(source EntropySource, r []rune) error {
+	for i := len(r) - 1; i > 0; i-- {
+		j, err := rand.Int(source, big.NewInt(int64(i+1)))
+		if err != nil {
+			return err
+		}
+
+		r[i], r[j.Int64()] = r[j.Int64()], r[i]
+	}
+
+	return nil
+}
+
+func randomChar(source EntropySource, chars []rune) (rune, error) {
+	idx, err := rand.Int(source, big.NewInt(int64(len(chars))))
+	if err != nil {
+		return 0, err
 	}
 
-	sort.Slice(result, func(i, j int) bool {
-		return order[i] < order[j]
-	})
+	return chars[idx.Int64()], nil
+}
+
+// distinct returns the characters of chars with any repeated characters
+// removed, preserving the order in which characters first appear.
+func distinct(chars string) []rune {
+	var result []rune
 
-	return result, nil
+	for i, c := range chars {
+		if !strings.ContainsRune(chars[:i], c) {
+			result = append(result, c)
+		}
+	}
+
+	return result
 }
 
-func generateRandomBytes(charSet *string, length int64) ([]byte, error) {
-	bytes := make([]byte, length)
-	setLen := big.NewInt(int64(len(*charSet)))
-	for i := range bytes {
-		idx, err := rand.Int(rand.Reader, setLen)
-		if err != nil {
-			return nil, err
+// EntropyBits returns an estimate of the entropy, in bits, of strings generated according to
+// the parameters. Each character satisfying the minimum of a class contributes the entropy of
+// a choice from that class, and each remaining character the entropy of a choice from the
+// characters of the alphabet which do not belong to a class with a maximum. The entropy of
+// the positions of characters is not counted. The result is rounded down to two decimal places.
+func (p StringParams) EntropyBits() float64 {
+	if p.Pronounceable {
+		return pronounceableEntropyBits(p)
+	}
+
+	var bits float64
+	var capped string
+	var mins int64
+
+	for _, class := range p.Classes() {
+		if class.Max > 0 {
+			capped += class.Characters
+		}
+
+		if class.Min > 0 {
+			bits += float64(class.Min) * math.Log2(float64(len(distinct(class.Characters))))
+			mins += class.Min
 		}
-		bytes[i] = (*charSet)[idx.Int64()]
 	}
-	return bytes, nil
+
+	var uncapped int
+
+	for _, c := range p.Alphabet() {
+		if !strings.ContainsRune(capped, c) {
+			uncapped++
+		}
+	}
+
+	if p.Length > mins && uncapped > 0 {
+		bits += float64(p.Length-mins) * math.Log2(float64(uncapped))
+	}
+
+	return math.Floor(bits*100) / 100
 }
@@ -0,0 +1,423 @@
+package random
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// chiSquared returns the chi-squared statistic of the observed counts against
+// a uniform distribution.
+func chiSquared(counts []int, total int) float64 {
+	expected := float64(total) / float64(len(counts))
+
+	var statistic float64
+
+	for _, count := range counts {
+		diff := float64(count) - expected
+		statistic += diff * diff / expected
+	}
+
+	return statistic
+}
+
+func TestShuffle_Uniform(t *testing.T) {
+	t.Parallel()
+
+	const iterations = 48000
+
+	source := NewReproducibleEntropySource("TestShuffle_Uniform")
+	permutations := map[string]int{}
+
+	for i := 0; i < iterations; i++ {
+		b := []rune("abcd")
+
+		if err := shuffle(source, b); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		permutations[string(b)]++
+	}
+
+	if len(permutations) != 24 {
+		t.Fatalf("expected all 24 permutations, got: %d", len(permutations))
+	}
+
+	counts := make([]int, 0, len(permutations))
+	for _, count := range permutations {
+		counts = append(counts, count)
+	}
+
+	// Critical value of the chi-squared distribution with 23 degrees of
+	// freedom at p = 0.001.
+	if statistic := chiSquared(counts, iterations); statistic > 49.73 {
+		t.Errorf("permutations are not uniformly distributed, chi-squared statistic: %f", statistic)
+	}
+}
+
+// The characters satisfying the min_* attributes must not favour any
+// position in the result.
+func TestCreateString_PositionalUniformity(t *testing.T) {
+	t.Parallel()
+
+	const iterations = 20000
+
+	params := StringParams{
+		Length:          8,
+		Upper:           true,
+		MinUpper:        2,
+		Special:         true,
+		OverrideSpecial: "!",
+		MinSpecial:      1,
+	}
+
+	source := NewReproducibleEntropySource("TestCreateString_PositionalUniformity")
+	counts := make([]int, params.Length)
+	total := 0
+
+	for i := 0; i < iterations; i++ {
+		result, err := CreateString(source, params)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		for position, char := range result {
+			if char == '!' {
+				counts[position]++
+				total++
+			}
+		}
+
+		if bytes.Count(result, []byte("!")) < 1 {
+			t.Fatalf("expected at least one special character, got: %s", result)
+		}
+	}
+
+	// Critical value of the chi-squared distribution with 7 degrees of
+	// freedom at p = 0.001.
+	if statistic := chiSquared(counts, total); statistic > 24.32 {
+		t.Errorf("special characters are not uniformly distributed across positions %v, chi-squared statistic: %f", counts, statistic)
+	}
+}
+
+// Character classes which share characters must each have their minimum
+// satisfied.
+func TestCreateString_OverlappingClasses(t *testing.T) {
+	t.Parallel()
+
+	params := StringParams{
+		Length:          4,
+		Numeric:         true,
+		MinNumeric:      2,
+		Special:         true,
+		OverrideSpecial: "0123456789",
+		MinSpecial:      2,
+	}
+
+	source := NewReproducibleEntropySource("TestCreateString_OverlappingClasses")
+
+	for i := 0; i < 100; i++ {
+		result, err := CreateString(source, params)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if len(result) != 4 {
+			t.Fatalf("expected length 4, got: %q", result)
+		}
+	}
+}
+
+func TestCreateString_CharacterClasses(t *testing.T) {
+	t.Parallel()
+
+	params := StringParams{
+		Length: 16,
+		Lower:  true,
+		CharacterClasses: []CharacterClass{
+			{Name: "hex", Characters: "0123456789abcdef", Min: 4, Max: 6},
+			{Name: "symbols", Characters: "~^", Min: 1, Max: 1},
+		},
+	}
+
+	source := NewReproducibleEntropySource("TestCreateString_CharacterClasses")
+
+	for i := 0; i < 1000; i++ {
+		result, err := CreateString(source, params)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if len(result) != 16 {
+			t.Fatalf("expected length 16, got: %q", result)
+		}
+
+		hex := 0
+		for _, c := range result {
+			if bytes.IndexByte([]byte("0123456789abcdef"), c) >= 0 {
+				hex++
+			}
+		}
+
+		if hex < 4 || hex > 6 {
+			t.Fatalf("expected between 4 and 6 hex characters, got %d: %q", hex, result)
+		}
+
+		if symbols := bytes.Count(result, []byte("~")) + bytes.Count(result, []byte("^")); symbols != 1 {
+			t.Fatalf("expected 1 symbol, got %d: %q", symbols, result)
+		}
+	}
+}
+
+func TestCreateString_CharacterClasses_Unsatisfiable(t *testing.T) {
+	t.Parallel()
+
+	params := StringParams{
+		Length: 4,
+		CharacterClasses: []CharacterClass{
+			{Name: "digits", Characters: "0123456789", Max: 2},
+		},
+	}
+
+	_, err := CreateString(NewReproducibleEntropySource("seed"), params)
+	if err == nil {
+		t.Error("expected error when the maximum of every class is reached")
+	}
+}
+
+// Multi-byte characters must each be used whole, and the length of the
+// result is the number of characters rather than bytes.
+func TestCreateString_MultiByte(t *testing.T) {
+	t.Parallel()
+
+	params := StringParams{
+		Length:          16,
+		Lower:           true,
+		MinLower:        2,
+		Special:         true,
+		OverrideSpecial: "€§",
+		MinSpecial:      2,
+		CharacterClasses: []CharacterClass{
+			{Name: "greek", Characters: "αβγδ", Min: 1, Max: 3},
+			{Name: "emoji", Characters: "🔑🔒", Min: 1},
+		},
+	}
+
+	source := NewReproducibleEntropySource("TestCreateString_MultiByte")
+
+	for i := 0; i < 1000; i++ {
+		result, err := CreateString(source, params)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if !utf8.Valid(result) {
+			t.Fatalf("expected valid UTF-8, got: %q", result)
+		}
+
+		if n := utf8.RuneCount(result); n != 16 {
+			t.Fatalf("expected 16 characters, got %d: %q", n, result)
+		}
+
+		s := string(result)
+
+		if n := strings.Count(s, "€") + strings.Count(s, "§"); n < 2 {
+			t.Fatalf("expected at least 2 special characters, got %d: %q", n, result)
+		}
+
+		greek := 0
+		for _, c := range s {
+			if strings.ContainsRune("αβγδ", c) {
+				greek++
+			}
+		}
+
+		if greek < 1 || greek > 3 {
+			t.Fatalf("expected between 1 and 3 greek characters, got %d: %q", greek, result)
+		}
+
+		if !strings.ContainsAny(s, "🔑🔒") {
+			t.Fatalf("expected at least 1 emoji, got: %q", result)
+		}
+	}
+}
+
+func TestCreateString_MultiByte_Exclude(t *testing.T) {
+	t.Parallel()
+
+	params := StringParams{
+		Length:            64,
+		Special:           true,
+		OverrideSpecial:   "€§£¥",
+		ExcludeCharacters: "§",
+	}
+
+	result, err := CreateString(NewReproducibleEntropySource("TestCreateString_MultiByte_Exclude"), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !utf8.Valid(result) || utf8.RuneCount(result) != 64 {
+		t.Fatalf("expected 64 valid UTF-8 characters, got: %q", result)
+	}
+
+	if bytes.ContainsRune(result, '§') {
+		t.Fatalf("expected no excluded characters, got: %q", result)
+	}
+}
+
+func TestStringParams_EntropyBits(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		params   StringParams
+		expected float64
+	}{
+		"numeric": {
+			params:   StringParams{Length: 4, Numeric: true},
+			expected: 13.28,
+		},
+		"all-classes": {
+			params:   StringParams{Length: 16, Upper: true, Lower: true, Numeric: true, Special: true},
+			expected: 102.0,
+		},
+		"min-upper": {
+			params:   StringParams{Length: 8, Upper: true, Lower: true, MinUpper: 2},
+			expected: 43.6,
+		},
+		"character-class-max": {
+			params: StringParams{
+				Length:           4,
+				Numeric:          true,
+				CharacterClasses: []CharacterClass{{Name: "ab", Characters: "ab", Max: 1}},
+			},
+			expected: 13.28,
+		},
+		"no-characters": {
+			params:   StringParams{Length: 4},
+			expected: 0,
+		},
+		"multi-byte": {
+			params:   StringParams{Length: 4, Special: true, OverrideSpecial: "€§£¥"},
+			expected: 8,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.params.EntropyBits(); got != testCase.expected {
+				t.Errorf("expected %v, got: %v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestStringParams_MaxLength(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		params          StringParams
+		expectedLength  int64
+		expectedLimited bool
+	}{
+		"no-max": {
+			params:          StringParams{Length: 4, Numeric: true, Lower: true},
+			expectedLimited: false,
+		},
+		"uncapped-characters": {
+			params: StringParams{
+				Length:           4,
+				Numeric:          true,
+				Lower:            true,
+				CharacterClasses: []CharacterClass{{Name: "digits", Characters: NumericChars, Max: 2}},
+			},
+			expectedLimited: false,
+		},
+		"capped": {
+			params: StringParams{
+				Length:           4,
+				Numeric:          true,
+				CharacterClasses: []CharacterClass{{Name: "digits", Characters: NumericChars, Max: 2}},
+			},
+			expectedLength:  2,
+			expectedLimited: true,
+		},
+		"capped-classes": {
+			params: StringParams{
+				Length: 8,
+				CharacterClasses: []CharacterClass{
+					{Name: "hex", Characters: "0123456789abcdef", Min: 1, Max: 3},
+					{Name: "symbols", Characters: "~^", Min: 1, Max: 2},
+				},
+			},
+			expectedLength:  5,
+			expectedLimited: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			length, limited := testCase.params.MaxLength()
+
+			if limited != testCase.expectedLimited || length != testCase.expectedLength {
+				t.Errorf("expected %d (%t), got: %d (%t)", testCase.expectedLength, testCase.expectedLimited, length, limited)
+			}
+		})
+	}
+}
+
+func TestCreateString_Exclude(t *testing.T) {
+	t.Parallel()
+
+	params := StringParams{
+		Length:            32,
+		Upper:             true,
+		Lower:             true,
+		Numeric:           true,
+		MinNumeric:        2,
+		Special:           true,
+		OverrideSpecial:   "|`-_",
+		ExcludeCharacters: "abc-",
+		ExcludeSimilar:    true,
+	}
+
+	source := NewReproducibleEntropySource("TestCreateString_Exclude")
+
+	for i := 0; i < 1000; i++ {
+		result, err := CreateString(source, params)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if bytes.ContainsAny(result, "abc-"+SimilarChars) {
+			t.Fatalf("expected no excluded characters, got: %q", result)
+		}
+	}
+}
+
+func TestCreateString_Exclude_EmptyClass(t *testing.T) {
+	t.Parallel()
+
+	params := StringParams{
+		Length:          8,
+		Lower:           true,
+		Special:         true,
+		OverrideSpecial: "|`",
+		MinSpecial:      1,
+		ExcludeSimilar:  true,
+	}
+
+	_, err := CreateString(NewReproducibleEntropySource("seed"), params)
+	if err == nil {
+		t.Error("expected error when the special class is excluded")
+	}
+}
@@ -0,0 +1,196 @@
+package random
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Verify returns an error if result could not have been generated according
+// to the parameters: if its length differs, if it contains a character which
+// may not be used, or if it does not satisfy the minimum and maximum of every
+// character class.
+func (p StringParams) Verify(result string) error {
+	length := int64(utf8.RuneCountInString(result))
+
+	if length != p.Length {
+		return fmt.Errorf("the length must be %d, got: %d", p.Length, length)
+	}
+
+	if p.Pronounceable {
+		return verifyPronounceableString(p, result)
+	}
+
+	classes := p.Classes()
+	usable := string(p.Alphabet())
+
+	for _, class := range classes {
+		if class.Min > 0 {
+			usable += class.Characters
+		}
+	}
+
+	for _, c := range result {
+		if !strings.ContainsRune(usable, c) {
+			return fmt.Errorf("the character %q may not be used in the result", c)
+		}
+	}
+
+	for _, class := range classes {
+		var count int64
+
+		for _, c := range result {
+			if strings.ContainsRune(class.Characters, c) {
+				count++
+			}
+		}
+
+		if count < class.Min {
+			return fmt.Errorf("the result must contain at least %d characters of the %s character class, got: %d",
+				class.Min, class.Name, count)
+		}
+
+		if class.Max > 0 && count > class.Max {
+			return fmt.Errorf("the result must contain at most %d characters of the %s character class, got: %d",
+				class.Max, class.Name, count)
+		}
+	}
+
+	return nil
+}
+
+// Verify returns an error if passphrase could not have been generated
+// according to the parameters: if it is not Words words of EFFLargeWordList
+// joined by Separator, capitalized if Capitalize is set, and with a digit
+// appended to exactly one word if IncludeNumber is set.
+func (p PassphraseParams) Verify(passphrase string) error {
+	if !verifyPassphraseWords(p, passphrase, p.Words, p.IncludeNumber) {
+		return fmt.Errorf("the passphrase must be %d words of the EFF large word list separated by %q, "+
+			"with capitalize %t and include_number %t", p.Words, p.Separator, p.Capitalize, p.IncludeNumber)
+	}
+
+	return nil
+}
+
+// verifyPassphraseWords returns whether passphrase is words words of
+// EFFLargeWordList joined by the separator. Each prefix of passphrase which
+// is a word is tried in turn, as words may contain the separator, such as
+// the hyphen of t-shirt.
+func verifyPassphraseWords(p PassphraseParams, passphrase string, words int64, number bool) bool {
+	if words == 0 {
+		return passphrase == "" && !number
+	}
+
+	first, size := utf8.DecodeRuneInString(passphrase)
+
+	if p.Capitalize && !unicode.IsUpper(first) {
+		return false
+	}
+
+	for i := 1; i <= len(passphrase) && i <= effLargeWordListMaxLen; i++ {
+		word := passphrase[:i]
+
+		if p.Capitalize {
+			if i < size {
+				continue
+			}
+
+			word = string(unicode.ToLower(first)) + word[size:]
+		}
+
+		if _, ok := effLargeWordSet[word]; !ok {
+			continue
+		}
+
+		rests := []string{passphrase[i:]}
+
+		if number && i < len(passphrase) && strings.ContainsRune(NumericChars, rune(passphrase[i])) {
+			rests = append(rests, passphrase[i+1:])
+		}
+
+		for j, rest := range rests {
+			if words > 1 {
+				if !strings.HasPrefix(rest, p.Separator) {
+					continue
+				}
+
+				rest = rest[len(p.Separator):]
+			}
+
+			if verifyPassphraseWords(p, rest, words-1, number && j == 0) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// InferStringParams returns the parameters with which result was most likely
+// generated: its length, with the numeric, lower, upper and special classes
+// enabled as they are by default, as a result may not contain a character of
+// every enabled class. Only characters which are not in the default classes
+// change the parameters, in which case OverrideSpecial is set to the special
+// characters of result, so that result can always be verified against the
+// returned parameters.
+func InferStringParams(result string) StringParams {
+	params := StringParams{
+		Length:  int64(utf8.RuneCountInString(result)),
+		Upper:   true,
+		Lower:   true,
+		Numeric: true,
+		Special: true,
+	}
+
+	var special, override []rune
+
+	for _, c := range result {
+		if strings.ContainsAny(NumericChars+LowerChars+UpperChars, string(c)) {
+			continue
+		}
+
+		special = append(special, c)
+
+		if !strings.ContainsRune(SpecialChars, c) {
+			override = append(override, c)
+		}
+	}
+
+	if len(override) > 0 {
+		chars := distinct(string(special))
+
+		sort.Slice(chars, func(i, j int) bool { return chars[i] < chars[j] })
+
+		params.OverrideSpecial = string(chars)
+	}
+
+	return params
+}
+
+func verifyPronounceableString(p StringParams, result string) error {
+	letters := p.Length - p.MinNumeric
+
+	var i int64
+
+	for _, c := range result {
+		chars, kind := NumericChars, "digit"
+
+		switch {
+		case i >= letters:
+		case i%2 == 0:
+			chars, kind = PronounceableConsonants, "consonant"
+		default:
+			chars, kind = PronounceableVowels, "vowel"
+		}
+
+		if !strings.ContainsRune(chars, c) {
+			return fmt.Errorf("the character %q at position %d must be a %s in pronounceable mode", c, i+1, kind)
+		}
+
+		i++
+	}
+
+	return nil
+}
@@ -0,0 +1,209 @@
+package random
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStringParams_Verify(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		params        StringParams
+		result        string
+		expectedError bool
+	}{
+		"default": {
+			params: StringParams{Length: 8, Upper: true, Lower: true, Numeric: true, Special: true},
+			result: "aB3$cD4%",
+		},
+		"length": {
+			params:        StringParams{Length: 8, Upper: true, Lower: true, Numeric: true, Special: true},
+			result:        "aB3$",
+			expectedError: true,
+		},
+		"disabled-class": {
+			params:        StringParams{Length: 4, Lower: true},
+			result:        "ab3d",
+			expectedError: true,
+		},
+		"min": {
+			params:        StringParams{Length: 4, Lower: true, Numeric: true, MinNumeric: 2},
+			result:        "abc3",
+			expectedError: true,
+		},
+		"min-disabled-class": {
+			params: StringParams{Length: 4, Lower: true, MinNumeric: 1},
+			result: "abc3",
+		},
+		"override-special": {
+			params:        StringParams{Length: 4, Lower: true, Special: true, OverrideSpecial: "_"},
+			result:        "ab$d",
+			expectedError: true,
+		},
+		"excluded": {
+			params:        StringParams{Length: 4, Lower: true, ExcludeCharacters: "a"},
+			result:        "abcd",
+			expectedError: true,
+		},
+		"character-class-max": {
+			params: StringParams{
+				Length:           4,
+				Lower:            true,
+				CharacterClasses: []CharacterClass{{Name: "vowels", Characters: "aeiou", Max: 1}},
+			},
+			result:        "abed",
+			expectedError: true,
+		},
+		"pronounceable": {
+			params: StringParams{Length: 6, MinNumeric: 2, Pronounceable: true},
+			result: "bodi42",
+		},
+		"pronounceable-mismatch": {
+			params:        StringParams{Length: 6, MinNumeric: 2, Pronounceable: true},
+			result:        "boid42",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.params.Verify(testCase.result)
+
+			if testCase.expectedError && err == nil {
+				t.Errorf("expected error, got none")
+			}
+
+			if !testCase.expectedError && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestPassphraseParams_Verify(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		params        PassphraseParams
+		passphrase    string
+		expectedError bool
+	}{
+		"default": {
+			params:     PassphraseParams{Words: 4, Separator: " "},
+			passphrase: "correct pony battery staple",
+		},
+		"words": {
+			params:        PassphraseParams{Words: 3, Separator: " "},
+			passphrase:    "correct pony battery staple",
+			expectedError: true,
+		},
+		"separator": {
+			params:     PassphraseParams{Words: 4, Separator: "-"},
+			passphrase: "correct-t-shirt-battery-staple",
+		},
+		"empty-separator": {
+			params:     PassphraseParams{Words: 2, Separator: ""},
+			passphrase: "correctpony",
+		},
+		"unknown-word": {
+			params:        PassphraseParams{Words: 4, Separator: " "},
+			passphrase:    "correct pony battery stapler",
+			expectedError: true,
+		},
+		"capitalize": {
+			params:     PassphraseParams{Words: 4, Separator: ".", Capitalize: true},
+			passphrase: "Correct.Pony.T-shirt.Staple",
+		},
+		"capitalize-missing": {
+			params:        PassphraseParams{Words: 4, Separator: ".", Capitalize: true},
+			passphrase:    "Correct.pony.Battery.Staple",
+			expectedError: true,
+		},
+		"capitalize-unexpected": {
+			params:        PassphraseParams{Words: 4, Separator: " "},
+			passphrase:    "Correct pony battery staple",
+			expectedError: true,
+		},
+		"include-number": {
+			params:     PassphraseParams{Words: 4, Separator: " ", IncludeNumber: true},
+			passphrase: "correct pony7 battery staple",
+		},
+		"include-number-missing": {
+			params:        PassphraseParams{Words: 4, Separator: " ", IncludeNumber: true},
+			passphrase:    "correct pony battery staple",
+			expectedError: true,
+		},
+		"include-number-twice": {
+			params:        PassphraseParams{Words: 4, Separator: " ", IncludeNumber: true},
+			passphrase:    "correct pony7 battery3 staple",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.params.Verify(testCase.passphrase)
+
+			if testCase.expectedError && err == nil {
+				t.Errorf("expected error, got none")
+			}
+
+			if !testCase.expectedError && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestInferStringParams(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		result   string
+		expected StringParams
+	}{
+		"lower": {
+			result:   "test",
+			expected: StringParams{Length: 4, Upper: true, Lower: true, Numeric: true, Special: true},
+		},
+		"all": {
+			result:   "aB3$",
+			expected: StringParams{Length: 4, Upper: true, Lower: true, Numeric: true, Special: true},
+		},
+		"override-special": {
+			result:   "a~b!c~",
+			expected: StringParams{Length: 6, Upper: true, Lower: true, Numeric: true, Special: true, OverrideSpecial: "!~"},
+		},
+		"multi-byte": {
+			result:   "ab€",
+			expected: StringParams{Length: 3, Upper: true, Lower: true, Numeric: true, Special: true, OverrideSpecial: "€"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := InferStringParams(testCase.result)
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("expected %+v, got: %+v", testCase.expected, got)
+			}
+
+			if err := got.Verify(testCase.result); err != nil {
+				t.Errorf("unexpected error verifying inferred params: %s", err)
+			}
+		})
+	}
+}